@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/lino-network/lino/exporter"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/auth"
@@ -17,18 +20,11 @@ import (
 	"github.com/lino-network/lino/x/proposal"
 
 	acc "github.com/lino-network/lino/x/account"
-	accmodel "github.com/lino-network/lino/x/account/model"
 	developer "github.com/lino-network/lino/x/developer"
-	devmodel "github.com/lino-network/lino/x/developer/model"
-	globalmodel "github.com/lino-network/lino/x/global/model"
 	infra "github.com/lino-network/lino/x/infra"
-	inframodel "github.com/lino-network/lino/x/infra/model"
-	postmodel "github.com/lino-network/lino/x/post/model"
 	rep "github.com/lino-network/lino/x/reputation"
 	val "github.com/lino-network/lino/x/validator"
-	valmodel "github.com/lino-network/lino/x/validator/model"
 	vote "github.com/lino-network/lino/x/vote"
-	votemodel "github.com/lino-network/lino/x/vote/model"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/tendermint/tendermint/libs/log"
@@ -46,16 +42,9 @@ const (
 	appName = "LinoBlockchain"
 
 	// state files
-	prevStateFolder     = "prevstates/"
-	currStateFolder     = "currstates/"
-	accountStateFile    = "account"
-	developerStateFile  = "developer"
-	postStateFile       = "post"
-	globalStateFile     = "global"
-	infraStateFile      = "infra"
-	validatorStateFile  = "validator"
-	reputationStateFile = "reputation"
-	voterStateFile      = "voter"
+	prevStateFolder   = "prevstates"
+	currStateFolder   = "currstates"
+	snapshotStateFile = "snapshot"
 )
 
 // default home directories for expected binaries
@@ -99,6 +88,16 @@ type LinoBlockchain struct {
 
 	// start from previous exported state
 	importRequired bool
+
+	// directories that upgrade states are exported to and imported from.
+	exportDir string
+	importDir string
+
+	// chain-id recorded in exported snapshot.
+	exportChainID string
+
+	// number of block journal entries already returned in begin block tags.
+	beginBlockJournalLen int
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -122,6 +121,8 @@ func NewLinoBlockchain(
 		CapKeyParamStore:      sdk.NewKVStoreKey(types.ParamKVStoreKey),
 		CapKeyProposalStore:   sdk.NewKVStoreKey(types.ProposalKVStoreKey),
 		CapKeyReputationStore: sdk.NewKVStoreKey(types.ReputationKVStoreKey),
+		exportDir:             DefaultExportDir(DefaultNodeHome),
+		importDir:             DefaultImportDir(DefaultNodeHome),
 	}
 	lb.paramHolder = param.NewParamHolder(lb.CapKeyParamStore)
 	lb.accountManager = acc.NewAccountManager(lb.CapKeyAccountStore, lb.paramHolder)
@@ -146,7 +147,8 @@ func NewLinoBlockchain(
 			lb.developerManager, lb.accountManager, &lb.globalManager)).
 		AddRoute(proposal.RouterKey, proposal.NewHandler(
 			lb.accountManager, lb.proposalManager, lb.postManager, &lb.globalManager, lb.voteManager)).
-		AddRoute(infra.RouterKey, infra.NewHandler(lb.infraManager)).
+		AddRoute(infra.RouterKey, infra.NewHandler(
+			lb.infraManager, lb.accountManager, &lb.globalManager, lb.developerManager)).
 		AddRoute(val.RouterKey, val.NewHandler(
 			lb.accountManager, lb.valManager, lb.voteManager, &lb.globalManager))
 
@@ -155,7 +157,7 @@ func NewLinoBlockchain(
 		AddRoute(post.QuerierRoute, post.NewQuerier(lb.postManager)).
 		AddRoute(vote.QuerierRoute, vote.NewQuerier(lb.voteManager)).
 		AddRoute(developer.QuerierRoute, developer.NewQuerier(lb.developerManager)).
-		AddRoute(proposal.QuerierRoute, proposal.NewQuerier(lb.proposalManager)).
+		AddRoute(proposal.QuerierRoute, proposal.NewQuerier(lb.proposalManager, lb.voteManager)).
 		AddRoute(infra.QuerierRoute, infra.NewQuerier(lb.infraManager)).
 		AddRoute(val.QuerierRoute, val.NewQuerier(lb.valManager)).
 		AddRoute(global.QuerierRoute, global.NewQuerier(lb.globalManager)).
@@ -165,7 +167,8 @@ func NewLinoBlockchain(
 	lb.SetInitChainer(lb.initChainer)
 	lb.SetBeginBlocker(lb.beginBlocker)
 	lb.SetEndBlocker(lb.endBlocker)
-	lb.SetAnteHandler(auth.NewAnteHandler(lb.accountManager, lb.globalManager, lb.postManager))
+	lb.SetAnteHandler(auth.NewAnteHandler(
+		lb.accountManager, lb.globalManager, lb.postManager, lb.developerManager, lb.paramHolder))
 	// TODO(Cosmos): mounting multiple stores is broken
 	// https://github.com/cosmos/cosmos-sdk/issues/532
 
@@ -231,8 +234,10 @@ func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(post.RewardEvent{}, "lino/eventReward", nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "lino/eventReturn", nil)
+	cdc.RegisterConcrete(acc.VestingReleaseEvent{}, "lino/eventVestingRelease", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
+	cdc.RegisterConcrete(post.SubscriptionEvent{}, "lino/eventSubscription", nil)
 }
 
 // SetImportRequired - set whether import is required in initchainer.
@@ -241,6 +246,33 @@ func (lb *LinoBlockchain) SetImportRequired(v bool) {
 	lb.importRequired = v
 }
 
+// SetExportDir - set the directory that ExportAppStateAndValidators writes to.
+// This can be done even after seal().
+func (lb *LinoBlockchain) SetExportDir(dir string) {
+	lb.exportDir = dir
+}
+
+// SetImportDir - set the directory that ImportFromFiles reads from.
+// This can be done even after seal().
+func (lb *LinoBlockchain) SetImportDir(dir string) {
+	lb.importDir = dir
+}
+
+// SetExportChainID - set the chain-id recorded in exported snapshot.
+func (lb *LinoBlockchain) SetExportChainID(chainID string) {
+	lb.exportChainID = chainID
+}
+
+// DefaultExportDir - export directory under node home.
+func DefaultExportDir(home string) string {
+	return filepath.Join(home, currStateFolder)
+}
+
+// DefaultImportDir - import directory under node home.
+func DefaultImportDir(home string) string {
+	return filepath.Join(home, prevStateFolder)
+}
+
 // custom logic for lino blockchain initialization
 func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	// set init time to zero
@@ -268,7 +300,9 @@ func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain
 			genesisState.GenesisParam.CoinDayParam,
 			genesisState.GenesisParam.BandwidthParam,
 			genesisState.GenesisParam.AccountParam,
-			genesisState.GenesisParam.ReputationParam); err != nil {
+			genesisState.GenesisParam.ReputationParam,
+			genesisState.GenesisParam.InfraParam,
+			genesisState.GenesisParam.FeatureParam); err != nil {
 			panic(err)
 		}
 	} else {
@@ -307,7 +341,7 @@ func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain
 
 	// import from prev state, do not read from genesis.
 	if lb.importRequired {
-		lb.ImportFromFiles(ctx)
+		lb.ImportFromFiles(ctx, genesisState.StateFiles)
 	} else {
 		// init genesis accounts
 		for _, gacc := range genesisState.Accounts {
@@ -416,7 +450,9 @@ func (lb *LinoBlockchain) toAppInfra(
 	if !lb.accountManager.DoesAccountExist(ctx, types.AccountKey(infra.Name)) {
 		return ErrGenesisFailed("genesis infra account doesn't exist")
 	}
-	if err := lb.infraManager.RegisterInfraProvider(ctx, types.AccountKey(infra.Name)); err != nil {
+	if err := lb.infraManager.RegisterInfraProvider(
+		ctx, types.AccountKey(infra.Name), types.NewCoinFromInt64(0),
+		types.StorageAndCDNInfra, "", ""); err != nil {
 		return err
 	}
 	return nil
@@ -424,6 +460,8 @@ func (lb *LinoBlockchain) toAppInfra(
 
 // init process for a block, execute time events and fire incompetent validators
 func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	lb.checkUpgradePlan(ctx)
+
 	chainStartTime, err := lb.globalManager.GetChainStartTime(ctx)
 	if err != nil {
 		panic(err)
@@ -446,8 +484,8 @@ func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlo
 		}
 	}
 
-	global.BeginBlocker(ctx, req, &lb.globalManager)
-	actualPenalty := val.BeginBlocker(ctx, req, lb.valManager)
+	tags := global.BeginBlocker(ctx, req, &lb.globalManager)
+	actualPenalty := val.BeginBlocker(ctx, req, lb.valManager, &lb.globalManager)
 
 	// add coins back to inflation pool
 	if err := lb.globalManager.AddToValidatorInflationPool(ctx, actualPenalty); err != nil {
@@ -455,12 +493,43 @@ func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlo
 	}
 
 	lb.syncInfoWithVoteManager(ctx)
-	lb.executeTimeEvents(ctx)
-	return abci.ResponseBeginBlock{}
+	tags = tags.AppendTags(lb.executeTimeEvents(ctx))
+	journalTags, journalLen := lb.blockJournalTags(ctx, 0)
+	lb.beginBlockJournalLen = journalLen
+	tags = tags.AppendTags(journalTags)
+	return abci.ResponseBeginBlock{
+		Tags: tags.ToKVPairs(),
+	}
+}
+
+// halt the chain at the height of the pending upgrade plan, unless this binary
+// implements the planned upgrade, in which case the plan is applied and removed.
+func (lb *LinoBlockchain) checkUpgradePlan(ctx sdk.Context) {
+	if !lb.proposalManager.DoesUpgradePlanExist(ctx) {
+		return
+	}
+	plan, err := lb.proposalManager.GetUpgradePlan(ctx)
+	if err != nil {
+		panic(err)
+	}
+	height := ctx.BlockHeader().Height
+	if height < plan.Height {
+		return
+	}
+	if plan.Name != types.ProtocolUpgradeName {
+		msg := fmt.Sprintf(
+			"UPGRADE %s NEEDED at height %d (proposal %s, %s), running binary implements %s",
+			plan.Name, plan.Height, plan.ProposalID, plan.Link, types.ProtocolUpgradeName)
+		ctx.Logger().Error(msg)
+		panic(msg)
+	}
+	ctx.Logger().Info(fmt.Sprintf("applying upgrade %s at height %d", plan.Name, height))
+	lb.proposalManager.DeleteUpgradePlan(ctx)
 }
 
 // execute events between last block time and current block time
-func (lb *LinoBlockchain) executeTimeEvents(ctx sdk.Context) {
+func (lb *LinoBlockchain) executeTimeEvents(ctx sdk.Context) sdk.Tags {
+	tags := sdk.EmptyTags()
 	currentTime := ctx.BlockHeader().Time.Unix()
 
 	lastBlockTime, err := lb.globalManager.GetLastBlockTime(ctx)
@@ -469,17 +538,19 @@ func (lb *LinoBlockchain) executeTimeEvents(ctx sdk.Context) {
 	}
 	for i := lastBlockTime; i < currentTime; i++ {
 		if timeEvents := lb.globalManager.GetTimeEventListAtTime(ctx, i); timeEvents != nil {
-			lb.executeEvents(ctx, timeEvents.Events)
+			tags = tags.AppendTags(lb.executeEvents(ctx, timeEvents.Events))
 			lb.globalManager.RemoveTimeEventList(ctx, i)
 		}
 	}
 	if err := lb.globalManager.SetLastBlockTime(ctx, currentTime); err != nil {
 		panic(err)
 	}
+	return tags
 }
 
-// execute events in list based on their type
-func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event) sdk.Error {
+// execute events in list based on their type, return tags of executed events
+func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event) sdk.Tags {
+	tags := sdk.EmptyTags()
 	for _, event := range eventList {
 		switch e := event.(type) {
 		case post.RewardEvent:
@@ -488,31 +559,73 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 				lb.developerManager, lb.voteManager, lb.reputationManager); err != nil {
 				panic(err)
 			}
+			tags = tags.AppendTags(sdk.NewTags(
+				types.TagEvent, "reward",
+				types.TagSender, string(e.Consumer),
+				types.TagReceiver, string(e.PostAuthor),
+				types.TagPermlink, string(types.GetPermlink(e.PostAuthor, e.PostID))))
+		case post.SubscriptionEvent:
+			donationTags, err := e.Execute(
+				ctx, lb.postManager, lb.accountManager, &lb.globalManager, lb.reputationManager)
+			if err != nil {
+				panic(err)
+			}
+			tags = tags.AppendTags(sdk.NewTags(
+				types.TagEvent, "subscription",
+				types.TagSender, string(e.Subscriber),
+				types.TagReceiver, string(e.Author),
+				types.TagPermlink, string(types.GetPermlink(e.Author, e.PostID)))).AppendTags(donationTags)
 		case acc.ReturnCoinEvent:
 			if err := e.Execute(ctx, lb.accountManager); err != nil {
 				panic(err)
 			}
+			if err := lb.globalManager.AddBalanceChange(ctx, e.Username, e.Amount, e.ReturnType); err != nil {
+				panic(err)
+			}
+			tags = tags.AppendTag(types.TagEvent, "returnCoin").
+				AppendTags(types.NewTransferTags("", e.Username, e.Amount, e.ReturnType))
+		case acc.VestingReleaseEvent:
+			released, err := e.Execute(ctx, lb.accountManager)
+			if err != nil {
+				panic(err)
+			}
+			// nothing is released from a cancelled transfer
+			if released.IsZero() {
+				continue
+			}
+			if err := lb.globalManager.AddBalanceChange(
+				ctx, e.Receiver, released, types.VestingTransferIn); err != nil {
+				panic(err)
+			}
+			tags = tags.AppendTag(types.TagEvent, "vestingRelease").
+				AppendTags(types.NewTransferTags(e.Sender, e.Receiver, released, types.VestingTransferIn))
 		case proposal.DecideProposalEvent:
 			if err := e.Execute(
 				ctx, lb.voteManager, lb.valManager, lb.accountManager, lb.proposalManager,
 				lb.postManager, &lb.globalManager); err != nil {
 				panic(err)
 			}
+			tags = tags.AppendTags(sdk.NewTags(
+				types.TagEvent, "decideProposal",
+				types.TagProposalID, string(e.ProposalID)))
 		case param.ChangeParamEvent:
 			if err := e.Execute(ctx, lb.paramHolder); err != nil {
 				panic(err)
 			}
+			tags = tags.AppendTag(types.TagEvent, "changeParam")
 		}
 	}
-	return nil
+	return tags
 }
 
 // udpate validator set and renew reputation round
 func (lb *LinoBlockchain) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	// XXX(yumin): reputation updates, will not change any tendermint.
-	rep.EndBlocker(ctx, req, lb.reputationManager)
+	tags := rep.EndBlocker(ctx, req, lb.reputationManager)
 
-	global.EndBlocker(ctx, req, &lb.globalManager)
+	tags = tags.AppendTags(global.EndBlocker(ctx, req, &lb.globalManager))
+	journalTags, _ := lb.blockJournalTags(ctx, lb.beginBlockJournalLen)
+	tags = tags.AppendTags(journalTags)
 	// update validator set.
 	validatorUpdates, err := lb.valManager.GetValidatorUpdates(ctx)
 	if err != nil {
@@ -520,9 +633,32 @@ func (lb *LinoBlockchain) endBlocker(ctx sdk.Context, req abci.RequestEndBlock)
 	}
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
+		Tags:             tags.ToKVPairs(),
 	}
 }
 
+// tags of balance changes in journal of current block starting from @p offset,
+// returns the tags and the length of the journal
+func (lb *LinoBlockchain) blockJournalTags(ctx sdk.Context, offset int) (sdk.Tags, int) {
+	journal, err := lb.globalManager.GetBlockJournal(ctx, ctx.BlockHeight())
+	if err != nil {
+		panic(err)
+	}
+	tags := sdk.EmptyTags()
+	for _, change := range journal.Changes[offset:] {
+		tags = tags.AppendTag(types.TagEvent, "balanceChange")
+		// incomes and outcomes are separated by TransferOut
+		if change.DetailType >= types.TransferOut {
+			tags = tags.AppendTags(
+				types.NewTransferTags(change.Username, "", change.Amount, change.DetailType))
+		} else {
+			tags = tags.AppendTags(
+				types.NewTransferTags("", change.Username, change.Amount, change.DetailType))
+		}
+	}
+	return tags, len(journal.Changes)
+}
+
 func (lb *LinoBlockchain) increaseMinute(ctx sdk.Context) {
 	pastMinutes, err := lb.globalManager.GetPastMinutes(ctx)
 	if err != nil {
@@ -589,43 +725,87 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 		// though only differs in round?
 		ratPerValidator = coin.ToDec().Quo(sdk.NewDec(int64(len(lst.OncallValidators) - i)))
 		coinPerValidator := types.DecToCoin(ratPerValidator)
-		lb.accountManager.AddSavingCoin(
-			ctx, validator, coinPerValidator, "", "", types.ValidatorInflation)
+		lb.addInflationToSaving(
+			ctx, validator, coinPerValidator, types.ValidatorInflation)
 		coin = coin.Minus(coinPerValidator)
 	}
 }
 
-// distribute inflation to infra provider monthly
+// distribute inflation to infra provider monthly, inflation is split between
+// storage and CDN by infra internal allocation, then by attested usage in each type
 // TODO: encaptulate module event inside module
 func (lb *LinoBlockchain) distributeInflationToInfraProvider(ctx sdk.Context) {
+	storageUsage, err := lb.infraManager.GetTotalAttestedUsage(ctx, types.StorageInfra)
+	if err != nil {
+		panic(err)
+	}
+	cdnUsage, err := lb.infraManager.GetTotalAttestedUsage(ctx, types.CDNInfra)
+	if err != nil {
+		panic(err)
+	}
+	// inflation is only distributed by attested usage, keep it in the pool if nothing is attested
+	if storageUsage.IsZero() && cdnUsage.IsZero() {
+		if err := lb.infraManager.ClearUsage(ctx); err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	inflation, err := lb.globalManager.GetInfraMonthlyInflation(ctx)
 	if err != nil {
 		panic(err)
 	}
+	allocation, err := lb.paramHolder.GetInfraInternalAllocationParam(ctx)
+	if err != nil {
+		panic(err)
+	}
 
-	lst, err := lb.infraManager.GetInfraProviderList(ctx)
+	storageProviders, err := lb.infraManager.GetInfraProvidersByType(ctx, types.StorageInfra)
+	if err != nil {
+		panic(err)
+	}
+	cdnProviders, err := lb.infraManager.GetInfraProvidersByType(ctx, types.CDNInfra)
 	if err != nil {
 		panic(err)
 	}
+	storageInflation := types.DecToCoin(inflation.ToDec().Mul(allocation.StorageAllocation))
+	cdnInflation := inflation.Minus(storageInflation)
+	// inflation of infra type without any attested usage goes to the other type
+	if storageUsage.IsZero() {
+		storageInflation = types.NewCoinFromInt64(0)
+		cdnInflation = inflation
+	} else if cdnUsage.IsZero() {
+		storageInflation = inflation
+		cdnInflation = types.NewCoinFromInt64(0)
+	}
+	lb.distributeInflationToInfraProviderByType(
+		ctx, storageInflation, storageProviders, types.StorageInfra)
+	lb.distributeInflationToInfraProviderByType(
+		ctx, cdnInflation, cdnProviders, types.CDNInfra)
+	if err := lb.infraManager.ClearUsage(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// distribute inflation to infra providers of given infra type by attested usage
+func (lb *LinoBlockchain) distributeInflationToInfraProviderByType(
+	ctx sdk.Context, inflation types.Coin, providers []types.AccountKey, infraType types.InfraType) {
 	totalDistributedInflation := types.NewCoinFromInt64(0)
-	for idx, provider := range lst.AllInfraProviders {
-		if idx == (len(lst.AllInfraProviders) - 1) {
-			lb.accountManager.AddSavingCoin(
-				ctx, provider, inflation.Minus(totalDistributedInflation), "", "", types.InfraInflation)
+	for idx, provider := range providers {
+		if idx == (len(providers) - 1) {
+			lb.addInflationToSaving(
+				ctx, provider, inflation.Minus(totalDistributedInflation), types.InfraInflation)
 			break
 		}
-		percentage, err := lb.infraManager.GetUsageWeight(ctx, provider)
+		percentage, err := lb.infraManager.GetUsageWeight(ctx, provider, infraType)
 		if err != nil {
 			panic(err)
 		}
 		myShareRat := inflation.ToDec().Mul(percentage)
 		myShareCoin := types.DecToCoin(myShareRat)
 		totalDistributedInflation = totalDistributedInflation.Plus(myShareCoin)
-		lb.accountManager.AddSavingCoin(
-			ctx, provider, myShareCoin, "", "", types.InfraInflation)
-	}
-	if err := lb.infraManager.ClearUsage(ctx); err != nil {
-		panic(err)
+		lb.addInflationToSaving(
+			ctx, provider, myShareCoin, types.InfraInflation)
 	}
 }
 
@@ -645,8 +825,8 @@ func (lb *LinoBlockchain) distributeInflationToDeveloper(ctx sdk.Context) {
 	totalDistributedInflation := types.NewCoinFromInt64(0)
 	for idx, developer := range lst.AllDevelopers {
 		if idx == (len(lst.AllDevelopers) - 1) {
-			lb.accountManager.AddSavingCoin(
-				ctx, developer, inflation.Minus(totalDistributedInflation), "", "", types.DeveloperInflation)
+			lb.addInflationToSaving(
+				ctx, developer, inflation.Minus(totalDistributedInflation), types.DeveloperInflation)
 			break
 		}
 		percentage, err := lb.developerManager.GetConsumptionWeight(ctx, developer)
@@ -656,8 +836,8 @@ func (lb *LinoBlockchain) distributeInflationToDeveloper(ctx sdk.Context) {
 		myShareRat := inflation.ToDec().Mul(percentage)
 		myShareCoin := types.DecToCoin(myShareRat)
 		totalDistributedInflation = totalDistributedInflation.Plus(myShareCoin)
-		lb.accountManager.AddSavingCoin(
-			ctx, developer, myShareCoin, "", "", types.DeveloperInflation)
+		lb.addInflationToSaving(
+			ctx, developer, myShareCoin, types.DeveloperInflation)
 	}
 
 	if err := lb.developerManager.ClearConsumption(ctx); err != nil {
@@ -665,6 +845,17 @@ func (lb *LinoBlockchain) distributeInflationToDeveloper(ctx sdk.Context) {
 	}
 }
 
+// add inflation to saving of @p username and record it in block journal
+func (lb *LinoBlockchain) addInflationToSaving(
+	ctx sdk.Context, username types.AccountKey, coin types.Coin, detailType types.TransferDetailType) {
+	if err := lb.accountManager.AddSavingCoin(ctx, username, coin, "", "", detailType); err != nil {
+		return
+	}
+	if err := lb.globalManager.AddBalanceChange(ctx, username, coin, detailType); err != nil {
+		panic(err)
+	}
+}
+
 func (lb *LinoBlockchain) syncInfoWithVoteManager(ctx sdk.Context) {
 	// tell voting committee the newest validators
 	validatorList, err := lb.valManager.GetValidatorList(ctx)
@@ -682,55 +873,61 @@ func (lb *LinoBlockchain) syncInfoWithVoteManager(ctx sdk.Context) {
 	}
 }
 
-// Custom logic for state export
+// rowExporter - stream every row of a module through write.
+type rowExporter func(ctx sdk.Context, write func(table string, row interface{}))
+
+// rowImporter - import a row of table, read decodes the row into the given pointer.
+type rowImporter func(ctx sdk.Context, table string, read func(row interface{}) error)
+
+// Custom logic for state export, rows are streamed to the snapshot one at a time
+// so memory usage does not grow with the size of the state.
 func (lb *LinoBlockchain) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	ctx := lb.NewContext(true, abci.Header{})
 
-	exportPath := DefaultNodeHome + "/" + currStateFolder
-	err = os.MkdirAll(exportPath, os.ModePerm)
+	err = os.MkdirAll(lb.exportDir, os.ModePerm)
 	if err != nil {
 		panic("failed to create export dir due to: " + err.Error())
 	}
 
-	exportToFile := func(filename string, exporter func(sdk.Context) interface{}) {
-		f, err := os.Create(exportPath + filename)
-		if err != nil {
-			panic("failed to create account")
-		}
-		defer f.Close()
-		jsonbytes, err := lb.cdc.MarshalJSON(exporter(ctx))
-		f.Write(jsonbytes)
+	w, err := exporter.CreateWriter(
+		filepath.Join(lb.exportDir, snapshotStateFile), lb.LastBlockHeight(), lb.exportChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	check := func(err error) {
 		if err != nil {
-			panic("failed to marshal json for " + filename + " due to " + err.Error())
+			panic("failed to export " + err.Error())
 		}
-		fmt.Printf("export for %s done: %d bytes\n", filename, len(jsonbytes))
-		f.Sync()
-	}
-
-	exportToFile(accountStateFile, func(ctx sdk.Context) interface{} {
-		return lb.accountManager.Export(ctx).ToIR()
-	})
-	exportToFile(developerStateFile, func(ctx sdk.Context) interface{} {
-		return lb.developerManager.Export(ctx).ToIR()
-	})
-	exportToFile(postStateFile, func(ctx sdk.Context) interface{} {
-		return lb.postManager.Export(ctx).ToIR()
-	})
-	exportToFile(globalStateFile, func(ctx sdk.Context) interface{} {
-		return lb.globalManager.Export(ctx).ToIR()
-	})
-	exportToFile(infraStateFile, func(ctx sdk.Context) interface{} {
-		return lb.infraManager.Export(ctx).ToIR()
-	})
-	exportToFile(validatorStateFile, func(ctx sdk.Context) interface{} {
-		return lb.valManager.Export(ctx).ToIR()
-	})
-	exportToFile(voterStateFile, func(ctx sdk.Context) interface{} {
-		return lb.voteManager.Export(ctx).ToIR()
-	})
-	lb.reputationManager.ExportToFile(ctx, exportPath+"reputation")
-
-	genesisState := GenesisState{}
+	}
+	exportSection := func(name string, exportRows rowExporter) {
+		check(w.BeginSection(name))
+		exportRows(ctx, func(table string, row interface{}) {
+			jsonbytes, err := lb.cdc.MarshalJSON(row)
+			if err != nil {
+				panic("failed to marshal json for " + table + " due to " + err.Error())
+			}
+			check(w.WriteRow(table, jsonbytes))
+		})
+		fmt.Printf("export for %s done: %d rows\n", name, w.Rows())
+		check(w.EndSection())
+	}
+
+	exportSection(exporter.AccountSection, lb.accountManager.ExportRows)
+	exportSection(exporter.DeveloperSection, lb.developerManager.ExportRows)
+	exportSection(exporter.PostSection, lb.postManager.ExportRows)
+	exportSection(exporter.GlobalSection, lb.globalManager.ExportRows)
+	exportSection(exporter.InfraSection, lb.infraManager.ExportRows)
+	exportSection(exporter.ValidatorSection, lb.valManager.ExportRows)
+	exportSection(exporter.VoterSection, lb.voteManager.ExportRows)
+	exportSection(exporter.ProposalSection, lb.proposalManager.ExportRows)
+	exportSection(exporter.ReputationSection, lb.reputationManager.ExportRows)
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	genesisState := GenesisState{
+		StateFiles: []StateFile{{Module: snapshotStateFile, Filename: snapshotStateFile}},
+	}
 
 	appState, err = wire.MarshalJSONIndent(lb.cdc, genesisState)
 	if err != nil {
@@ -739,71 +936,81 @@ func (lb *LinoBlockchain) ExportAppStateAndValidators() (appState json.RawMessag
 	return appState, validators, nil
 }
 
-// ImportFromFiles Custom logic for state export
-func (lb *LinoBlockchain) ImportFromFiles(ctx sdk.Context) {
+// ImportFromFiles Custom logic for state import. The snapshot is looked up in the import
+// dir by the manifest written during export, and refused if its version or any of its
+// section hashes does not match, or if any module's section is missing. The chain-id of
+// the snapshot is informational only, state is imported to a new chain-id on upgrade.
+// Rows are read and imported one at a time.
+func (lb *LinoBlockchain) ImportFromFiles(ctx sdk.Context, stateFiles []StateFile) {
 	check := func(err error) {
 		if err != nil {
-			panic("failed to unmarshal " + err.Error())
+			panic("failed to import " + err.Error())
 		}
 	}
-	importFromFile := func(filename string, tables interface{}) {
-		// XXX(yumin): does not support customized node home import.
-		f, err := os.Open(DefaultNodeHome + "/" + prevStateFolder + filename)
-		if err != nil {
-			panic("failed to open " + err.Error())
+	filename := filepath.Join(lb.importDir, snapshotStateFile)
+	for _, file := range stateFiles {
+		if file.Module == snapshotStateFile {
+			filename = filepath.Join(lb.importDir, file.Filename)
+		}
+	}
+	// verify the whole snapshot before any row is imported.
+	check(exporter.Verify(filename))
+
+	importers := map[string]rowImporter{
+		exporter.AccountSection:    lb.accountManager.ImportRow,
+		exporter.DeveloperSection:  lb.developerManager.ImportRow,
+		exporter.PostSection:       lb.postManager.ImportRow,
+		exporter.GlobalSection:     lb.globalManager.ImportRow,
+		exporter.InfraSection:      lb.infraManager.ImportRow,
+		exporter.ValidatorSection:  lb.valManager.ImportRow,
+		exporter.VoterSection:      lb.voteManager.ImportRow,
+		exporter.ProposalSection:   lb.proposalManager.ImportRow,
+		exporter.ReputationSection: lb.reputationManager.ImportRow,
+	}
+
+	r, err := exporter.OpenReader(filename)
+	check(err)
+	defer r.Close()
+	header := r.Header()
+	fmt.Printf("snapshot %s verified: version %d, height %d, chain-id %s\n",
+		filename, header.Version, header.Height, header.ChainID)
+	imported := map[string]bool{}
+	for {
+		section, err := r.NextSection()
+		if err == io.EOF {
+			break
 		}
-		defer f.Close()
-		bytes, err := ioutil.ReadAll(f)
 		check(err)
-		// XXX(yumin): ugly, trying found a better way.
-		switch t := tables.(type) {
-		case *accmodel.AccountTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.accountManager.Import(ctx, t)
-		case *devmodel.DeveloperTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.developerManager.Import(ctx, t)
-		case *globalmodel.GlobalTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.globalManager.Import(ctx, t)
-		case *inframodel.InfraTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.infraManager.Import(ctx, t)
-		case *postmodel.PostTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.postManager.Import(ctx, t)
-		case *valmodel.ValidatorTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.valManager.Import(ctx, t)
-		case *votemodel.VoterTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
+		importRow, ok := importers[section]
+		if !ok {
+			panic("failed to import unknown section " + section)
+		}
+		if imported[section] {
+			panic("failed to import duplicate section " + section)
+		}
+		imported[section] = true
+		rows := 0
+		for {
+			table, bytes, err := r.NextRow()
+			if err == io.EOF {
+				break
+			}
 			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
-			lb.voteManager.Import(ctx, t)
-		default:
-			panic(fmt.Sprintf("Unknown import type: %T", t))
+			importRow(ctx, table, func(row interface{}) error {
+				return lb.cdc.UnmarshalJSON(bytes, row)
+			})
+			rows++
 		}
-		fmt.Printf("%s loaded, total %d bytes\n", filename, len(bytes))
+		fmt.Printf("%s state loaded, total %d rows\n", section, rows)
+	}
+	missing := []string{}
+	for section := range importers {
+		if !imported[section] {
+			missing = append(missing, section)
+		}
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		panic("failed to import, missing sections " + strings.Join(missing, ", "))
 	}
-
-	importFromFile(accountStateFile, &accmodel.AccountTablesIR{})
-	importFromFile(developerStateFile, &devmodel.DeveloperTablesIR{})
-	importFromFile(postStateFile, &postmodel.PostTablesIR{})
-	importFromFile(globalStateFile, &globalmodel.GlobalTablesIR{})
-	importFromFile(infraStateFile, &inframodel.InfraTablesIR{})
-	importFromFile(validatorStateFile, &valmodel.ValidatorTablesIR{})
-	importFromFile(voterStateFile, &votemodel.VoterTablesIR{})
-	lb.reputationManager.ImportFromFile(ctx, DefaultNodeHome+"/"+prevStateFolder+reputationStateFile)
 }
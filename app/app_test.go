@@ -2,7 +2,9 @@ package app
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -16,12 +18,15 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/lino-network/lino/exporter"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
 	devModel "github.com/lino-network/lino/x/developer/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	infraModel "github.com/lino-network/lino/x/infra/model"
 	"github.com/lino-network/lino/x/post"
+	proposalModel "github.com/lino-network/lino/x/proposal/model"
 )
 
 var (
@@ -241,12 +246,15 @@ func TestGenesisFromConfig(t *testing.T) {
 			SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 			CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 			VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+			FeeEnabled:                  false,
+			MinGasPrice:                 types.NewDecFromRat(1, 10),
 		},
 		param.AccountParam{
 			MinimumBalance:               types.NewCoinFromInt64(1 * types.Decimals),
 			RegisterFee:                  types.NewCoinFromInt64(0),
 			FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(0),
 			MaxNumFrozenMoney:            10,
+			MaxNumBalanceHistory:         1000,
 		},
 		param.PostParam{
 			ReportOrUpvoteIntervalSec: 24 * 3600,
@@ -256,6 +264,16 @@ func TestGenesisFromConfig(t *testing.T) {
 		param.ReputationParam{
 			BestContentIndexN: 10,
 		},
+		param.InfraParam{
+			InfraMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+			InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+			InfraCoinReturnTimes:       int64(7),
+		},
+		param.FeatureParam{
+			Features: []param.FeatureActivation{
+				{Name: types.FeatureNoCostDonation, Height: 1},
+			},
+		},
 	}
 	genesisState.InitGlobalMeta = globalModel.InitParamList{
 		MaxTPS:                       sdk.NewDec(1000),
@@ -296,6 +314,9 @@ func TestGenesisFromConfig(t *testing.T) {
 	infraAllocationParam, err := lb.paramHolder.GetInfraInternalAllocationParam(ctx)
 	assert.Nil(t, err)
 	assert.Equal(t, genesisState.GenesisParam.InfraInternalAllocationParam, *infraAllocationParam)
+	featureParam, err := lb.paramHolder.GetFeatureParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, genesisState.GenesisParam.FeatureParam, *featureParam)
 }
 
 func TestDistributeInflationToValidators(t *testing.T) {
@@ -415,49 +436,102 @@ func TestDistributeInflationToInfraProvider(t *testing.T) {
 	cases := map[string]struct {
 		beforeDistributionInflationPool types.Coin
 		pastMinutes                     int64
-		numberOfInfraProvider           int
-		consumptionList                 []int64
+		infraTypeList                   []types.InfraType
+		storageUsageList                []int64
+		cdnUsageList                    []int64
+		expectInflationList             []types.Coin
+		expectKeepInflationPool         bool
 	}{
 		"first distribution": {
 			beforeDistributionInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
 			pastMinutes:                     types.MinutesPerMonth,
-			numberOfInfraProvider:           1,
-			consumptionList:                 []int64{0},
+			infraTypeList:                   []types.InfraType{types.StorageAndCDNInfra},
+			storageUsageList:                []int64{1},
+			cdnUsageList:                    []int64{1},
+			expectInflationList:             []types.Coin{types.NewCoinFromInt64(1000 * types.Decimals)},
+		},
+		"test inflation is kept in pool if no usage is attested": {
+			beforeDistributionInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
+			pastMinutes:                     types.MinutesPerMonth,
+			infraTypeList: []types.InfraType{
+				types.StorageAndCDNInfra, types.StorageAndCDNInfra},
+			storageUsageList: []int64{0, 0},
+			cdnUsageList:     []int64{0, 0},
+			expectInflationList: []types.Coin{
+				types.NewCoinFromInt64(0), types.NewCoinFromInt64(0)},
+			expectKeepInflationPool: true,
 		},
 		"test distribution need to be rounded case": {
 			beforeDistributionInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
 			pastMinutes:                     types.MinutesPerMonth,
-			numberOfInfraProvider:           3,
-			consumptionList:                 []int64{0, 0, 0},
+			infraTypeList: []types.InfraType{
+				types.StorageAndCDNInfra, types.StorageAndCDNInfra, types.StorageAndCDNInfra},
+			storageUsageList: []int64{1, 1, 1},
+			cdnUsageList:     []int64{1, 1, 1},
+			expectInflationList: []types.Coin{
+				types.NewCoinFromInt64(33333334), types.NewCoinFromInt64(33333334),
+				types.NewCoinFromInt64(33333332)},
 		},
 		"test distribution based on consumption": {
 			beforeDistributionInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
 			pastMinutes:                     types.MinutesPerMonth,
-			numberOfInfraProvider:           3,
-			consumptionList:                 []int64{10, 0, 20},
+			infraTypeList: []types.InfraType{
+				types.StorageAndCDNInfra, types.StorageAndCDNInfra, types.StorageAndCDNInfra},
+			storageUsageList: []int64{10, 0, 20},
+			cdnUsageList:     []int64{10, 0, 20},
+			expectInflationList: []types.Coin{
+				types.NewCoinFromInt64(33333334), types.NewCoinFromInt64(0),
+				types.NewCoinFromInt64(66666666)},
+		},
+		"test storage and CDN are distributed separately": {
+			beforeDistributionInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
+			pastMinutes:                     types.MinutesPerMonth,
+			infraTypeList: []types.InfraType{
+				types.StorageInfra, types.CDNInfra, types.StorageAndCDNInfra},
+			storageUsageList: []int64{30, 0, 10},
+			cdnUsageList:     []int64{0, 20, 20},
+			expectInflationList: []types.Coin{
+				types.NewCoinFromInt64(37500000), types.NewCoinFromInt64(25000000),
+				types.NewCoinFromInt64(37500000)},
+		},
+		"test CDN inflation goes to storage if there is no CDN provider": {
+			beforeDistributionInflationPool: types.NewCoinFromInt64(1000 * types.Decimals),
+			pastMinutes:                     types.MinutesPerMonth,
+			infraTypeList:                   []types.InfraType{types.StorageInfra, types.StorageInfra},
+			storageUsageList:                []int64{1, 3},
+			cdnUsageList:                    []int64{0, 0},
+			expectInflationList: []types.Coin{
+				types.NewCoinFromInt64(25000000), types.NewCoinFromInt64(75000000)},
 		},
 	}
 	for testName, cs := range cases {
 		lb := newLinoBlockchain(t, 21)
 		ctx := lb.BaseApp.NewContext(true, abci.Header{})
 		infraStorage := infraModel.NewInfraProviderStorage(lb.CapKeyInfraStore)
-		totalWeight := int64(0)
-		for i := 0; i < cs.numberOfInfraProvider; i++ {
+		for i, infraType := range cs.infraTypeList {
+			provider := types.AccountKey("infra" + strconv.Itoa(i))
 			err := lb.accountManager.CreateAccount(
-				ctx, "", types.AccountKey("infra"+strconv.Itoa(i)),
+				ctx, "", provider,
 				secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(), types.NewCoinFromInt64(0))
 			if err != nil {
 				t.Errorf("%s: failed to register account, got err %v", testName, err)
 			}
-			err = lb.infraManager.RegisterInfraProvider(ctx, types.AccountKey("infra"+strconv.Itoa(i)))
+			err = lb.infraManager.RegisterInfraProvider(
+				ctx, provider, types.NewCoinFromInt64(0), infraType, "", "")
 			if err != nil {
 				t.Errorf("%s: failed to register infra provider, got err %v", testName, err)
 			}
-			infra, _ := infraStorage.GetInfraProvider(ctx, types.AccountKey("infra"+strconv.Itoa(i)))
-			infra.Usage = cs.consumptionList[i]
-			infraStorage.SetInfraProvider(ctx, types.AccountKey("infra"+strconv.Itoa(i)), infra)
-			totalWeight = totalWeight + cs.consumptionList[i]
+			if cs.storageUsageList[i] > 0 {
+				err = lb.infraManager.AttestUsage(
+					ctx, "app", provider, types.StorageInfra, cs.storageUsageList[i])
+				assert.Nil(t, err)
+			}
+			if cs.cdnUsageList[i] > 0 {
+				err = lb.infraManager.AttestUsage(
+					ctx, "app", provider, types.CDNInfra, cs.cdnUsageList[i])
+				assert.Nil(t, err)
+			}
 		}
 		globalStore := globalModel.NewGlobalStorage(lb.CapKeyGlobalStore)
 		err := globalStore.SetInflationPool(ctx, &globalModel.InflationPool{
@@ -473,46 +547,31 @@ func TestDistributeInflationToInfraProvider(t *testing.T) {
 			t.Errorf("%s: failed to get inflation pool, got err %v", testName, err)
 		}
 
-		if !inflationPool.InfraInflationPool.IsZero() {
+		expectInflationPool := types.NewCoinFromInt64(0)
+		if cs.expectKeepInflationPool {
+			expectInflationPool = cs.beforeDistributionInflationPool
+		}
+		if !inflationPool.InfraInflationPool.IsEqual(expectInflationPool) {
 			t.Errorf(
 				"%s: diff infra inflation pool, got %v, want %v",
-				testName, inflationPool.InfraInflationPool,
-				types.NewCoinFromInt64(0))
+				testName, inflationPool.InfraInflationPool, expectInflationPool)
 			return
 		}
 
-		actualInflation := types.NewCoinFromInt64(0)
-		for i := 0; i < cs.numberOfInfraProvider; i++ {
-			saving, err :=
-				lb.accountManager.GetSavingFromBank(
-					ctx, types.AccountKey("infra"+strconv.Itoa(i)))
+		for i := range cs.infraTypeList {
+			provider := types.AccountKey("infra" + strconv.Itoa(i))
+			saving, err := lb.accountManager.GetSavingFromBank(ctx, provider)
 			assert.Nil(t, err)
-			var inflation types.Coin
-			if totalWeight == 0 {
-				inflation =
-					types.DecToCoin(
-						types.NewDecFromRat(1, int64(cs.numberOfInfraProvider)).
-							Mul(cs.beforeDistributionInflationPool.ToDec()))
-			} else {
-				inflation =
-					types.DecToCoin(
-						types.NewDecFromRat(cs.consumptionList[i], totalWeight).
-							Mul(cs.beforeDistributionInflationPool.ToDec()))
-			}
-			if i == (cs.numberOfInfraProvider - 1) {
-				inflation = cs.beforeDistributionInflationPool.Minus(actualInflation)
-			}
-			actualInflation = actualInflation.Plus(inflation)
-			if !saving.IsEqual(inflation) {
+			if !saving.IsEqual(cs.expectInflationList[i]) {
 				t.Errorf(
 					"%s: diff inflation for %v, got %v, want %v",
-					testName, "dev"+strconv.Itoa(i), inflation,
-					saving)
+					testName, provider, saving, cs.expectInflationList[i])
 				return
 			}
-			infra, err := infraStorage.GetInfraProvider(ctx, types.AccountKey("infra"+strconv.Itoa(i)))
+			infra, err := infraStorage.GetInfraProvider(ctx, provider)
 			assert.Nil(t, err)
-			assert.Equal(t, infra.Usage, int64(0))
+			assert.Equal(t, int64(0), infra.AttestedStorageUsage)
+			assert.Equal(t, int64(0), infra.AttestedCDNUsage)
 		}
 	}
 	for testName, cs := range cases {
@@ -521,10 +580,15 @@ func TestDistributeInflationToInfraProvider(t *testing.T) {
 		if err != nil {
 			t.Errorf("%s: failed to set past minutes, got err %v", testName, err)
 		}
-		err = lb.infraManager.RegisterInfraProvider(ctx, "Lino")
+		err = lb.infraManager.RegisterInfraProvider(
+			ctx, "Lino", types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
 		if err != nil {
 			t.Errorf("%s: failed to register infra provider, got err %v", testName, err)
 		}
+		err = lb.infraManager.AttestUsage(ctx, "app", "Lino", types.StorageInfra, 1)
+		if err != nil {
+			t.Errorf("%s: failed to attest usage, got err %v", testName, err)
+		}
 
 		globalStore := globalModel.NewGlobalStorage(lb.CapKeyGlobalStore)
 		err = globalStore.SetInflationPool(ctx, &globalModel.InflationPool{
@@ -724,6 +788,35 @@ func TestHourlyEvent(t *testing.T) {
 	}
 }
 
+func TestExecuteVestingReleaseEvent(t *testing.T) {
+	lb := newLinoBlockchain(t, 2)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{Height: 2, Time: time.Unix(0, 0)})
+	sender, receiver := types.AccountKey(user1), types.AccountKey("validator1")
+	amount := types.NewCoinFromInt64(300 * types.Decimals)
+	piece := types.NewCoinFromInt64(100 * types.Decimals)
+
+	transfer, err := lb.accountManager.AddVestingTransfer(ctx, sender, receiver, amount, 3600, 3, "")
+	assert.Nil(t, err)
+	releaseEvent := acc.VestingReleaseEvent{
+		Sender: sender, Receiver: receiver, VestingID: transfer.ID, Amount: piece}
+
+	tags := lb.executeEvents(ctx, []types.Event{releaseEvent})
+	assert.Equal(t, sdk.EmptyTags().AppendTag(types.TagEvent, "vestingRelease").
+		AppendTags(types.NewTransferTags(sender, receiver, piece, types.VestingTransferIn)), tags)
+	journal, err := lb.globalManager.GetBlockJournal(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []globalModel.BalanceChange{
+		{Username: receiver, Amount: piece, DetailType: types.VestingTransferIn}}, journal.Changes)
+
+	// nothing is released after the transfer is cancelled
+	assert.Nil(t, lb.accountManager.CancelVestingTransfer(ctx, sender, transfer.ID))
+	tags = lb.executeEvents(ctx, []types.Event{releaseEvent})
+	assert.Equal(t, 0, len(tags))
+	journal, err = lb.globalManager.GetBlockJournal(ctx, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(journal.Changes))
+}
+
 func TestIncreaseMinute(t *testing.T) {
 	lb := newLinoBlockchain(t, 21)
 	ctx := lb.BaseApp.NewContext(true, abci.Header{})
@@ -780,6 +873,40 @@ func TestIncreaseMinute(t *testing.T) {
 	}
 }
 
+func TestCheckUpgradePlan(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{Height: 10})
+	// no pending plan
+	lb.checkUpgradePlan(ctx)
+
+	plan := &proposalModel.UpgradePlan{
+		ProposalID: types.ProposalKey("1"),
+		Name:       "next-upgrade",
+		Height:     20,
+		Link:       "link",
+	}
+	assert.Nil(t, lb.proposalManager.SetUpgradePlan(ctx, plan))
+	lb.checkUpgradePlan(ctx)
+	assert.True(t, lb.proposalManager.DoesUpgradePlanExist(ctx))
+
+	// running binary doesn't implement the upgrade, halt at plan height
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 20})
+	assert.Panics(t, func() { lb.checkUpgradePlan(ctx) })
+	assert.True(t, lb.proposalManager.DoesUpgradePlanExist(ctx))
+
+	// binary implementing the upgrade keeps running before plan height
+	plan.Name = types.ProtocolUpgradeName
+	assert.Nil(t, lb.proposalManager.SetUpgradePlan(ctx, plan))
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 19})
+	lb.checkUpgradePlan(ctx)
+	assert.True(t, lb.proposalManager.DoesUpgradePlanExist(ctx))
+
+	// binary implementing the upgrade applies the plan
+	ctx = lb.BaseApp.NewContext(true, abci.Header{Height: 20})
+	lb.checkUpgradePlan(ctx)
+	assert.False(t, lb.proposalManager.DoesUpgradePlanExist(ctx))
+}
+
 func TestGlobalTime(t *testing.T) {
 	logger, db := loggerAndDB()
 	lb := NewLinoBlockchain(logger, db, nil)
@@ -856,3 +983,99 @@ func TestGlobalTime(t *testing.T) {
 		assert.Equal(t, cs.expectLastBlockTime, lastBlockTime)
 	}
 }
+
+func TestExportImportStateDir(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	dir, err := ioutil.TempDir("", "lino-state")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	lb.SetExportDir(dir)
+	appState, _, err := lb.ExportAppStateAndValidators()
+	assert.Nil(t, err)
+
+	genesisState := new(GenesisState)
+	assert.Nil(t, lb.cdc.UnmarshalJSON(appState, genesisState))
+	assert.Equal(t, 1, len(genesisState.StateFiles))
+	for _, file := range genesisState.StateFiles {
+		_, err := os.Stat(filepath.Join(dir, file.Filename))
+		assert.Nil(t, err)
+	}
+
+	logger, db := loggerAndDB()
+	imported := NewLinoBlockchain(logger, db, nil)
+	imported.SetImportRequired(true)
+	imported.SetImportDir(dir)
+	imported.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	imported.Commit()
+
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	importedCtx := imported.BaseApp.NewContext(true, abci.Header{})
+	for i := 0; i < 21; i++ {
+		user := types.AccountKey("validator" + strconv.Itoa(i))
+		saving, err := lb.accountManager.GetSavingFromBank(ctx, user)
+		assert.Nil(t, err)
+		importedSaving, err := imported.accountManager.GetSavingFromBank(importedCtx, user)
+		assert.Nil(t, err)
+		assert.Equal(t, saving, importedSaving)
+	}
+}
+
+func TestImportFromFilesRefused(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	dir, err := ioutil.TempDir("", "lino-state")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	lb.SetExportDir(dir)
+	lb.SetExportChainID("lino-1")
+	appState, _, err := lb.ExportAppStateAndValidators()
+	assert.Nil(t, err)
+
+	newImported := func() *LinoBlockchain {
+		logger, db := loggerAndDB()
+		imported := NewLinoBlockchain(logger, db, nil)
+		imported.SetImportRequired(true)
+		imported.SetImportDir(dir)
+		return imported
+	}
+
+	// snapshot of previous chain is imported to the upgraded chain
+	assert.NotPanics(t, func() {
+		newImported().InitChain(abci.RequestInitChain{ChainId: "lino-2", AppStateBytes: appState})
+	})
+
+	// snapshot without some of the sections
+	w, err := exporter.CreateWriter(filepath.Join(dir, snapshotStateFile), lb.LastBlockHeight(), "lino-1")
+	assert.Nil(t, err)
+	assert.Nil(t, w.BeginSection(exporter.AccountSection))
+	assert.Nil(t, w.EndSection())
+	assert.Nil(t, w.Close())
+	assert.Panics(t, func() {
+		newImported().InitChain(abci.RequestInitChain{ChainId: "lino-2", AppStateBytes: appState})
+	})
+}
+
+func TestVerifyExport(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	dir, err := ioutil.TempDir("", "lino-state")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	replayDir, err := ioutil.TempDir("", "lino-replay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(replayDir)
+
+	lb.SetExportDir(dir)
+	_, _, err = lb.ExportAppStateAndValidators()
+	assert.Nil(t, err)
+
+	logger, _ := loggerAndDB()
+	diffs, err := VerifyExport(logger, dir, replayDir)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(diffs))
+	for _, diff := range diffs {
+		if !diff.Equal() {
+			t.Errorf("%s/%s: diff after replay, got %+v", diff.Section, diff.Table, diff)
+		}
+	}
+}
@@ -31,6 +31,14 @@ type GenesisState struct {
 	GenesisParam   GenesisParam              `json:"genesis_param"`
 	InitGlobalMeta globalModel.InitParamList `json:"init_global_meta"`
 	Reputation     []byte                    `json:"reputation"`
+	StateFiles     []StateFile               `json:"state_files"`
+}
+
+// StateFile - a module state file written by upgrade export, Filename is relative
+// to the export (and later import) directory.
+type StateFile struct {
+	Module   string `json:"module"`
+	Filename string `json:"filename"`
 }
 
 // genesis account will get coin to the address and register user
@@ -73,6 +81,8 @@ type GenesisParam struct {
 	param.AccountParam
 	param.PostParam
 	param.ReputationParam
+	param.InfraParam
+	param.FeatureParam
 }
 
 // LinoBlockchainGenTx - init genesis account
@@ -183,12 +193,15 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 				CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 				VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+				FeeEnabled:                  false,
+				MinGasPrice:                 types.NewDecFromRat(1, 10),
 			},
 			param.AccountParam{
 				MinimumBalance:               types.NewCoinFromInt64(0),
 				RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 				FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 				MaxNumFrozenMoney:            10,
+				MaxNumBalanceHistory:         1000,
 			},
 			param.PostParam{
 				ReportOrUpvoteIntervalSec: 24 * 3600,
@@ -198,6 +211,12 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 			param.ReputationParam{
 				BestContentIndexN: 10,
 			},
+			param.InfraParam{
+				InfraMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+				InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+				InfraCoinReturnTimes:       int64(7),
+			},
+			param.DefaultFeatureParam(),
 		},
 		InitGlobalMeta: globalModel.InitParamList{
 			MaxTPS:                       sdk.NewDec(1000),
@@ -103,12 +103,15 @@ func TestGetGenesisJson(t *testing.T) {
 				SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 				CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 				VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+				FeeEnabled:                  false,
+				MinGasPrice:                 types.NewDecFromRat(1, 10),
 			},
 			param.AccountParam{
 				MinimumBalance:               types.NewCoinFromInt64(0),
 				RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 				FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 				MaxNumFrozenMoney:            10,
+				MaxNumBalanceHistory:         1000,
 			},
 			param.PostParam{
 				ReportOrUpvoteIntervalSec: 24 * 3600,
@@ -118,6 +121,12 @@ func TestGetGenesisJson(t *testing.T) {
 			param.ReputationParam{
 				BestContentIndexN: 10,
 			},
+			param.InfraParam{
+				InfraMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+				InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+				InfraCoinReturnTimes:       int64(7),
+			},
+			param.DefaultFeatureParam(),
 		},
 		InitGlobalMeta: globalModel.InitParamList{
 			MaxTPS:                       sdk.NewDec(1000),
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/lino-network/lino/exporter"
+)
+
+const (
+	flagSnapshotDir = "export-dir"
+	flagReplayDir   = "replay-dir"
+)
+
+// VerifyExport - import the snapshot in @p dir into a fresh in-memory blockchain
+// through ImportFromFiles, export it again to @p replayDir and diff both snapshots.
+func VerifyExport(logger log.Logger, dir, replayDir string) (diffs []exporter.TableDiff, err error) {
+	filename := filepath.Join(dir, snapshotStateFile)
+	r, err := exporter.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	header := r.Header()
+	r.Close()
+
+	lb := NewLinoBlockchain(logger, dbm.NewMemDB(), nil)
+	lb.SetImportRequired(true)
+	lb.SetImportDir(dir)
+	lb.SetExportDir(replayDir)
+	lb.SetExportChainID(header.ChainID)
+
+	appState, err := lb.cdc.MarshalJSON(GenesisState{
+		StateFiles: []StateFile{{Module: snapshotStateFile, Filename: snapshotStateFile}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// import and export panic on failure, report it as an error instead.
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("replay failed: %v", p)
+		}
+	}()
+	lb.InitChain(abci.RequestInitChain{ChainId: header.ChainID, AppStateBytes: appState})
+	lb.Commit()
+	if _, _, err := lb.ExportAppStateAndValidators(); err != nil {
+		return nil, err
+	}
+	return exporter.Diff(filename, filepath.Join(replayDir, snapshotStateFile))
+}
+
+// VerifyExportCmd - replay an exported snapshot and report per-table differences.
+func VerifyExportCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-export",
+		Short: "Import exported state into an in-memory chain, export it again and diff",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dir := viper.GetString(flagSnapshotDir)
+			if dir == "" {
+				dir = DefaultExportDir(viper.GetString(tmcli.HomeFlag))
+			}
+			replayDir := viper.GetString(flagReplayDir)
+			if replayDir == "" {
+				tmp, err := ioutil.TempDir("", "lino-replay")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(tmp)
+				replayDir = tmp
+			} else if err := os.MkdirAll(replayDir, os.ModePerm); err != nil {
+				return err
+			}
+
+			diffs, err := VerifyExport(ctx.Logger, dir, replayDir)
+			if err != nil {
+				return err
+			}
+			mismatch := 0
+			for _, diff := range diffs {
+				status := "ok"
+				if !diff.Equal() {
+					status = "MISMATCH"
+					mismatch++
+				}
+				fmt.Printf("%s/%s: source %d rows, replay %d rows, %s\n",
+					diff.Section, diff.Table, diff.SourceRows, diff.ReplayRows, status)
+				for _, row := range diff.Changed {
+					fmt.Printf("  changed %q: %v\n", row.Key, row.Fields)
+				}
+				for _, key := range diff.Missing {
+					fmt.Printf("  missing in replay %q\n", key)
+				}
+				for _, key := range diff.Extra {
+					fmt.Printf("  extra in replay %q\n", key)
+				}
+			}
+			if mismatch != 0 {
+				return fmt.Errorf("%d of %d tables differ after replay", mismatch, len(diffs))
+			}
+			fmt.Printf("all %d tables match\n", len(diffs))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSnapshotDir, "", "directory of exported state to verify, default to $home/currstates")
+	cmd.Flags().String(flagReplayDir, "", "directory to write replayed export to, default to a temp dir")
+	return cmd
+}
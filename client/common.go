@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/lino-network/lino/client/core"
 	"github.com/spf13/cobra"
@@ -21,10 +22,26 @@ func NewCoreContextFromViper() core.CoreContext {
 		rpc = rpcclient.NewHTTP(nodeURI, "/websocket")
 	}
 	var privKey crypto.PrivKey
+	var privKeys []crypto.PrivKey
 	privKeyStr := viper.GetString(FlagPrivKey)
 	if privKeyStr != "" {
-		privKeyBytes, _ := hex.DecodeString(viper.GetString(FlagPrivKey))
-		privKey, _ = cryptoAmino.PrivKeyFromBytes(privKeyBytes)
+		// several comma separated private keys sign for a multisig key.
+		for _, str := range strings.Split(privKeyStr, ",") {
+			privKeyBytes, _ := hex.DecodeString(str)
+			key, _ := cryptoAmino.PrivKeyFromBytes(privKeyBytes)
+			privKeys = append(privKeys, key)
+		}
+		privKey = privKeys[0]
+	}
+	var appPrivKey crypto.PrivKey
+	if appPrivKeyStr := viper.GetString(FlagAppPrivKey); appPrivKeyStr != "" {
+		appPrivKeyBytes, _ := hex.DecodeString(appPrivKeyStr)
+		appPrivKey, _ = cryptoAmino.PrivKeyFromBytes(appPrivKeyBytes)
+	}
+	var multisigPubKey crypto.PubKey
+	if multisigStr := viper.GetString(FlagMultisigPubKey); multisigStr != "" {
+		multisigBytes, _ := hex.DecodeString(multisigStr)
+		multisigPubKey, _ = cryptoAmino.PubKeyFromBytes(multisigBytes)
 	}
 
 	if viper.GetInt64(FlagSequence) < 0 {
@@ -38,8 +55,12 @@ func NewCoreContextFromViper() core.CoreContext {
 		FromAddressName: viper.GetString(FlagName),
 		NodeURI:         nodeURI,
 		Sequence:        uint64(viper.GetInt64(FlagSequence)), // XXX(yumin): dangerous, but ok.
+		Fee:             viper.GetInt64(FlagFee),
 		Client:          rpc,
 		PrivKey:         privKey,
+		AppPrivKey:      appPrivKey,
+		MultisigPubKey:  multisigPubKey,
+		PrivKeys:        privKeys,
 	}
 }
 
@@ -15,8 +15,14 @@ type CoreContext struct {
 	FromAddressName string
 	Sequence        uint64
 	Memo            string
+	Fee             int64
 	Client          rpcclient.Client
 	PrivKey         crypto.PrivKey
+	// AppPrivKey - if set, app key of developer co-signs the transaction.
+	AppPrivKey crypto.PrivKey
+	// MultisigPubKey - if set, PrivKeys sign for the threshold multisig key.
+	MultisigPubKey crypto.PubKey
+	PrivKeys       []crypto.PrivKey
 }
 
 // WithChainID - mount chain id on context
@@ -55,6 +61,12 @@ func (c CoreContext) WithSequence(sequence uint64) CoreContext {
 	return c
 }
 
+// WithFee - mount transaction fee on context
+func (c CoreContext) WithFee(fee int64) CoreContext {
+	c.Fee = fee
+	return c
+}
+
 // WithClient - mount client on context
 func (c CoreContext) WithClient(client rpcclient.Client) CoreContext {
 	c.Client = client
@@ -66,3 +78,16 @@ func (c CoreContext) WithPrivKey(privKey crypto.PrivKey) CoreContext {
 	c.PrivKey = privKey
 	return c
 }
+
+// WithAppPrivKey - mount app private key co-signing the transaction on context
+func (c CoreContext) WithAppPrivKey(appPrivKey crypto.PrivKey) CoreContext {
+	c.AppPrivKey = appPrivKey
+	return c
+}
+
+// WithMultisig - mount threshold multisig public key and private keys signing for it on context
+func (c CoreContext) WithMultisig(multisigPubKey crypto.PubKey, privKeys []crypto.PrivKey) CoreContext {
+	c.MultisigPubKey = multisigPubKey
+	c.PrivKeys = privKeys
+	return c
+}
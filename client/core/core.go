@@ -10,9 +10,12 @@ import (
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/lino-network/lino/types"
 )
 
 // BroadcastTx - broadcast the transaction bytes to Tendermint
@@ -55,9 +58,17 @@ func (ctx CoreContext) QuerySubspace(cdc *wire.Codec, subspace []byte, storeName
 	return
 }
 
+// QueryRoute - query from Tendermint with the provided querier route, e.g. "account/bank/user"
+func (ctx CoreContext) QueryRoute(route string) (res []byte, err error) {
+	return ctx.queryPath(fmt.Sprintf("/custom/%s", route), nil)
+}
+
 // Query from Tendermint with the provided storename and path
 func (ctx CoreContext) query(key cmn.HexBytes, storeName, endPath string) (res []byte, err error) {
-	path := fmt.Sprintf("/store/%s/%s", storeName, endPath)
+	return ctx.queryPath(fmt.Sprintf("/store/%s/%s", storeName, endPath), key)
+}
+
+func (ctx CoreContext) queryPath(path string, key cmn.HexBytes) (res []byte, err error) {
 	node, err := ctx.GetNode()
 	if err != nil {
 		return res, err
@@ -93,9 +104,15 @@ func (ctx CoreContext) SignAndBuild(msgs []sdk.Msg, cdc *wire.Codec) ([]byte, er
 		Sequence:      sequence,
 		Msgs:          msgs,
 	}
+	if ctx.Fee > 0 {
+		signMsg.Fee = auth.StdFee{Amount: sdk.Coins{sdk.NewInt64Coin(types.TxFeeDenom, ctx.Fee)}}
+	}
 
 	// sign and build
 	bz := signMsg.Bytes()
+	if ctx.MultisigPubKey != nil {
+		return ctx.multisignAndBuild(signMsg, bz, cdc)
+	}
 	if ctx.PrivKey == nil {
 		return nil, errors.New("Must provide private key")
 	}
@@ -110,12 +127,63 @@ func (ctx CoreContext) SignAndBuild(msgs []sdk.Msg, cdc *wire.Codec) ([]byte, er
 		// and probably remove all these and use cosmos's build-in support functions.
 		// Sequence:  sequence,
 	}}
+	sigs, err = ctx.appendAppSig(sigs, bz)
+	if err != nil {
+		return nil, err
+	}
 
 	// marshal bytes
 	tx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, memo)
 	return cdc.MarshalJSON(tx)
 }
 
+// app co-signs the same bytes as the signer, appended after signer's signature
+func (ctx CoreContext) appendAppSig(sigs []auth.StdSignature, bz []byte) ([]auth.StdSignature, error) {
+	if ctx.AppPrivKey == nil {
+		return sigs, nil
+	}
+	sig, err := ctx.AppPrivKey.Sign(bz)
+	if err != nil {
+		return nil, err
+	}
+	return append(sigs, auth.StdSignature{
+		PubKey:    ctx.AppPrivKey.PubKey(),
+		Signature: sig,
+	}), nil
+}
+
+// aggregate signatures of private keys into a signature of the threshold multisig key
+func (ctx CoreContext) multisignAndBuild(
+	signMsg txbuilder.StdSignMsg, bz []byte, cdc *wire.Codec) ([]byte, error) {
+	multisigKey, ok := ctx.MultisigPubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return nil, errors.New("Multisig public key must be a threshold multisig key")
+	}
+	if uint(len(ctx.PrivKeys)) < multisigKey.K {
+		return nil, errors.Errorf(
+			"Must provide at least %d private keys of the multisig key, got %d", multisigKey.K, len(ctx.PrivKeys))
+	}
+	mSig := multisig.NewMultisig(len(multisigKey.PubKeys))
+	for _, privKey := range ctx.PrivKeys {
+		sig, err := privKey.Sign(bz)
+		if err != nil {
+			return nil, err
+		}
+		if err := mSig.AddSignatureFromPubKey(sig, privKey.PubKey(), multisigKey.PubKeys); err != nil {
+			return nil, err
+		}
+	}
+	sigs, err := ctx.appendAppSig([]auth.StdSignature{{
+		PubKey:    multisigKey,
+		Signature: mSig.Marshal(),
+	}}, bz)
+	if err != nil {
+		return nil, err
+	}
+	tx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, ctx.Memo)
+	return cdc.MarshalJSON(tx)
+}
+
 // sign and build the transaction from the msg
 func (ctx CoreContext) SignBuildBroadcast(
 	msgs []sdk.Msg, cdc *wire.Codec) (*ctypes.ResultBroadcastTxCommit, error) {
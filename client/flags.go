@@ -4,15 +4,22 @@ import "github.com/spf13/cobra"
 
 // nolint
 const (
-	FlagChainID   = "chain-id"
-	FlagNode      = "node"
-	FlagHeight    = "height"
-	FlagTrustNode = "trust-node"
-	FlagName      = "name"
-	FlagSequence  = "sequence"
-	FlagFee       = "fee"
-	FlagPrivKey   = "priv-key"
-	FlagPubKey    = "pub-key"
+	FlagChainID    = "chain-id"
+	FlagNode       = "node"
+	FlagHeight     = "height"
+	FlagTrustNode  = "trust-node"
+	FlagName       = "name"
+	FlagSequence   = "sequence"
+	FlagFee        = "fee"
+	FlagPrivKey    = "priv-key"
+	FlagPubKey     = "pub-key"
+	FlagAppPrivKey = "app-priv-key"
+	FlagApp        = "app"
+
+	// Multisig
+	FlagMultisigPubKey    = "multisig-pub-key"
+	FlagMultisigThreshold = "multisig-threshold"
+	FlagMultisigPubKeys   = "multisig-pub-keys"
 
 	// Account
 	FlagIsFollow = "is-follow"
@@ -22,6 +29,12 @@ const (
 	FlagReceiver = "receiver"
 	FlagAmount   = "amount"
 	FlagMemo     = "memo"
+	FlagBefore   = "before"
+	FlagLimit    = "limit"
+	FlagStart    = "start"
+	FlagTimes    = "times"
+	FlagInterval = "interval-sec"
+	FlagVesting  = "vesting-id"
 
 	// Developer
 	FlagDeveloper   = "developer"
@@ -37,8 +50,9 @@ const (
 	FlagGrantAmount = "grant-amount"
 
 	// Infra
-	FlagProvider = "provider"
-	FlagUsage    = "usage"
+	FlagProvider  = "provider"
+	FlagUsage     = "usage"
+	FlagInfraType = "infra-type"
 
 	// Post
 	FlagDonator                 = "donator"
@@ -53,12 +67,23 @@ const (
 	FlagSourceAuthor            = "source-author"
 	FlagSourcePostID            = "source-post-ID"
 	FlagRedistributionSplitRate = "redistribution-split-rate"
+	FlagTags                    = "tags"
+	FlagKeepRevisionContent     = "keep-revision-content"
 
 	// Vote
 	FlagVoter      = "voter"
 	FlagProposalID = "proposal-id"
 	FlagResult     = "result"
 	FlagLink       = "link"
+
+	// Proposal
+	FlagCreator       = "creator"
+	FlagReason        = "reason"
+	FlagParamType     = "param-type"
+	FlagParamFile     = "param-file"
+	FlagDryRun        = "dry-run"
+	FlagRevision      = "revision"
+	FlagUpgradeHeight = "upgrade-height"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -81,7 +106,11 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
 		c.Flags().Int64(FlagSequence, 0, "Sequence number to sign the tx")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
-		c.Flags().String(FlagPrivKey, "", "Private key to sign the transaction")
+		c.Flags().String(FlagPrivKey, "", "Private key to sign the transaction, comma separated keys to sign for a multisig key")
+		c.Flags().String(FlagMultisigPubKey, "", "Threshold multisig public key the private keys sign for")
+		c.Flags().String(FlagAppPrivKey, "", "App private key of developer to co-sign the transaction")
+		c.Flags().String(FlagApp, "", "App the transaction is sent through, co-signed by its app private key")
+		c.Flags().Int64(FlagFee, 0, "Transaction fee in coin, pays for the tx instead of bandwidth if fee is enabled")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 	}
 	return cmds
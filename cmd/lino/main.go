@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"io"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -18,15 +19,37 @@ import (
 	"github.com/lino-network/lino/app"
 )
 
+const (
+	flagExportDir = "export-dir"
+	flagImportDir = "import-dir"
+)
+
 // generate Lino application
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
 	app := app.NewLinoBlockchain(logger, db, traceStore,
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
 	// after upgrade-1, lino needs to starts
 	app.SetImportRequired(true)
+	app.SetImportDir(importDir())
 	return app
 }
 
+// importDir - directory of previous exported state, default under node home.
+func importDir() string {
+	if dir := viper.GetString(flagImportDir); dir != "" {
+		return dir
+	}
+	return app.DefaultImportDir(viper.GetString(cli.HomeFlag))
+}
+
+// exportDir - directory to export current state to, default under node home.
+func exportDir() string {
+	if dir := viper.GetString(flagExportDir); dir != "" {
+		return dir
+	}
+	return app.DefaultExportDir(viper.GetString(cli.HomeFlag))
+}
+
 func main() {
 	cobra.EnableCommandSorting = false
 
@@ -40,8 +63,17 @@ func main() {
 	}
 
 	rootCmd.AddCommand(app.InitCmd(ctx, cdc))
+	rootCmd.AddCommand(app.VerifyExportCmd(ctx))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	for _, cmd := range rootCmd.Commands() {
+		switch cmd.Name() {
+		case "start":
+			cmd.Flags().String(flagImportDir, "", "directory of exported state to import, default to $home/prevstates")
+		case "export":
+			cmd.Flags().String(flagExportDir, "", "directory to export state to, default to $home/currstates")
+		}
+	}
 
 	executor := cli.PrepareBaseCmd(rootCmd, "BC", app.DefaultNodeHome)
 	executor.Execute()
@@ -50,5 +82,12 @@ func main() {
 func exportAppStateAndTMValidators(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	_ int64, _ bool, _ []string) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 	lb := app.NewLinoBlockchain(logger, db, traceStore)
+	lb.SetExportDir(exportDir())
+	genDoc, err := tmtypes.GenesisDocFromFile(
+		filepath.Join(viper.GetString(cli.HomeFlag), "config", "genesis.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	lb.SetExportChainID(genDoc.ChainID)
 	return lb.ExportAppStateAndValidators()
 }
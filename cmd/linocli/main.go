@@ -83,6 +83,15 @@ func main() {
 		client.PostCommands(
 			acccmd.TransferTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(acccmd.MultisigPubKeyCmd())
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			acccmd.VestingTransferTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			acccmd.CancelVestingTransferTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.PostTxCmd(cdc),
@@ -103,6 +112,11 @@ func main() {
 		client.PostCommands(
 			postcmd.DonateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.SubscribeTxCmd(cdc),
+			postcmd.CancelSubscriptionTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			validatorcmd.DepositValidatorTxCmd(cdc),
@@ -153,6 +167,32 @@ func main() {
 		client.PostCommands(
 			proposalcmd.VoteProposalTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			proposalcmd.ChangeParamProposalTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			proposalcmd.DeletePostContentProposalTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			proposalcmd.UpgradeProtocolProposalTxCmd(cdc),
+		)...)
+
+	proposalCmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Proposal querying subcommands",
+	}
+	proposalCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.ShowProposalCmd(cdc),
+		)...)
+	proposalCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.GetProposalVotesCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(proposalCmd)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -163,6 +203,22 @@ func main() {
 		client.PostCommands(
 			infracmd.ProviderReportTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.ProviderRegisterTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.ProviderUpdateTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.ProviderRevokeTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.AttestUsageTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			developercmd.DeveloperRegisterTxCmd(cdc),
@@ -200,6 +256,14 @@ func main() {
 		client.GetCommands(
 			acccmd.GetAccountsCmd(types.AccountKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetBalanceHistoryCmd(types.AccountKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetVestingTransfersCmd(types.AccountKVStoreKey, cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			postcmd.GetPostCmd(types.PostKVStoreKey, cdc),
@@ -208,6 +272,25 @@ func main() {
 		client.GetCommands(
 			postcmd.GetPostsCmd(types.PostKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostCommentsCmd(types.PostKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostViewsCmd(types.PostKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostReportOrUpvotesCmd(types.PostKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostDonationsCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostsByTagCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostRevisionsCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetSubscriptionsCmd(types.PostKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
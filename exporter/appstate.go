@@ -1,33 +1,293 @@
 package exporter
 
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// SnapshotVersion - version of the snapshot format written by this binary,
+// a snapshot of any other version is refused on import.
+// v2: rows are streamed one per line instead of one JSON document per section.
+const SnapshotVersion int64 = 2
+
+// section names of the snapshot, one for each module.
+const (
+	AccountSection    = "account"
+	DeveloperSection  = "developer"
+	PostSection       = "post"
+	GlobalSection     = "global"
+	InfraSection      = "infra"
+	ValidatorSection  = "validator"
+	VoterSection      = "voter"
+	ProposalSection   = "proposal"
+	ReputationSection = "reputation"
+)
+
 // AppState contains all informations that needs to migrate when blockchain upgrade.
+// A snapshot is a newline delimited JSON file, its first line is the AppState header.
+// Each module is then written as a section: a line that opens the section, one line
+// per table row holding the row's amino JSON, and a line that closes the section
+// with the number of rows and the SHA-256 of all row lines in between.
 type AppState struct {
-	// Accounts            []AccountRow     `json:"accounts"`
-	// AccountGrantPubKeys []GrantPubKeyRow `json:"account_grant_pub_keys"`
+	Version int64  `json:"version"`
+	Height  int64  `json:"height"`
+	ChainID string `json:"chain_id"`
+}
+
+// line - every line after the AppState header.
+type line struct {
+	Section string          `json:"section,omitempty"`
+	Table   string          `json:"table,omitempty"`
+	Row     json.RawMessage `json:"row,omitempty"`
+	End     string          `json:"end,omitempty"`
+	Rows    int64           `json:"rows,omitempty"`
+	SHA256  string          `json:"sha256,omitempty"`
+}
+
+// encode - one JSON value per line, row data is hashed byte by byte so
+// it must not be escaped again.
+func encode(v interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
 
-	// Developers           []Developer
-	// DeveloperList        DeveloperList
+// Writer - writes a snapshot row by row.
+type Writer struct {
+	f       *os.File
+	w       *bufio.Writer
+	section string
+	hash    hash.Hash
+	rows    int64
+}
+
+// CreateWriter - create @p file and write the AppState header.
+func CreateWriter(file string, height int64, chainID string) (*Writer, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	w := &Writer{f: f, w: bufio.NewWriter(f)}
+	if err := w.writeLine(AppState{
+		Version: SnapshotVersion,
+		Height:  height,
+		ChainID: chainID,
+	}); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return w, nil
+}
 
-	// GlobalTimeEventLists []GlobalTimeEventList
-	// GlobalStakeStats     []GlobalStakeStat
-	// GlobalMisc           GlobalMisc
+func (w *Writer) writeLine(v interface{}) error {
+	bz, err := encode(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(bz)
+	return err
+}
 
-	// InfraProviders       []InfraProvider
-	// InfraProviderList    InfraProviderList
+// BeginSection - open section @p name.
+func (w *Writer) BeginSection(name string) error {
+	if w.section != "" {
+		return fmt.Errorf("section %s is not ended", w.section)
+	}
+	w.section = name
+	w.hash = sha256.New()
+	w.rows = 0
+	return w.writeLine(line{Section: name})
+}
 
-	// Posts                []Post
-	// PostUsers            []PostUser
-	// PostComments         []PostComment
+// WriteRow - write amino JSON of a row in @p table to current section.
+func (w *Writer) WriteRow(table string, row []byte) error {
+	if w.section == "" {
+		return fmt.Errorf("row of %s written outside of section", table)
+	}
+	bz, err := encode(line{Table: table, Row: json.RawMessage(row)})
+	if err != nil {
+		return err
+	}
+	w.hash.Write(bz)
+	w.rows++
+	_, err = w.w.Write(bz)
+	return err
+}
 
-	// proposal is skipped
+// EndSection - close current section with its row count and hash.
+func (w *Writer) EndSection() error {
+	if w.section == "" {
+		return fmt.Errorf("no section to end")
+	}
+	err := w.writeLine(line{
+		End:    w.section,
+		Rows:   w.rows,
+		SHA256: hex.EncodeToString(w.hash.Sum(nil)),
+	})
+	w.section = ""
+	return err
+}
 
-	// Voters        []VoterRow         `json:"voters"`
-	// Delegations   []DelegationRow    `json:"delegations"`
-	// ReferenceList ReferenceListTable `json:"reference_list"`
+// Rows - number of rows written to current section.
+func (w *Writer) Rows() int64 {
+	return w.rows
+}
 
-	// Validators    []Validator
-	// ValidatorList ValidatorList
+// Close - flush and close the snapshot file.
+func (w *Writer) Close() error {
+	defer w.f.Close()
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// Reader - reads a snapshot row by row, hash and row count of a section
+// are checked when its end is reached.
+type Reader struct {
+	f       *os.File
+	r       *bufio.Reader
+	header  AppState
+	section string
+	hash    hash.Hash
+	rows    int64
+}
+
+// OpenReader - open @p file and check the version of its AppState header.
+func OpenReader(file string) (*Reader, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{f: f, r: bufio.NewReader(f)}
+	bz, err := r.readLine()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to read snapshot header: %s", err)
+	}
+	if err := json.Unmarshal(bz, &r.header); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if r.header.Version != SnapshotVersion {
+		f.Close()
+		return nil, fmt.Errorf(
+			"snapshot version mismatch, expect %d, got %d", SnapshotVersion, r.header.Version)
+	}
+	return r, nil
+}
+
+func (r *Reader) readLine() ([]byte, error) {
+	bz, err := r.r.ReadBytes('\n')
+	if err == io.EOF && len(bz) != 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return bz, err
+}
+
+// Header - AppState header of the snapshot.
+func (r *Reader) Header() AppState {
+	return r.header
+}
+
+// NextSection - open next section, returns io.EOF when there is no more section.
+func (r *Reader) NextSection() (string, error) {
+	if r.section != "" {
+		return "", fmt.Errorf("section %s is not finished", r.section)
+	}
+	bz, err := r.readLine()
+	if err != nil {
+		return "", err
+	}
+	l := line{}
+	if err := json.Unmarshal(bz, &l); err != nil {
+		return "", err
+	}
+	if l.Section == "" {
+		return "", fmt.Errorf("expect section begin, got %s", string(bz))
+	}
+	r.section = l.Section
+	r.hash = sha256.New()
+	r.rows = 0
+	return r.section, nil
+}
+
+// NextRow - return table and amino JSON of next row in current section,
+// returns io.EOF when the section ends and its hash and row count match.
+func (r *Reader) NextRow() (string, []byte, error) {
+	if r.section == "" {
+		return "", nil, fmt.Errorf("no section to read")
+	}
+	bz, err := r.readLine()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return "", nil, err
+	}
+	l := line{}
+	if err := json.Unmarshal(bz, &l); err != nil {
+		return "", nil, err
+	}
+	if l.End == "" {
+		r.hash.Write(bz)
+		r.rows++
+		return l.Table, l.Row, nil
+	}
+	if l.End != r.section {
+		return "", nil, fmt.Errorf("section %s ended by %s", r.section, l.End)
+	}
+	if l.Rows != r.rows {
+		return "", nil, fmt.Errorf(
+			"section %s row count mismatch, expect %d, got %d", r.section, l.Rows, r.rows)
+	}
+	if hash := hex.EncodeToString(r.hash.Sum(nil)); hash != l.SHA256 {
+		return "", nil, fmt.Errorf(
+			"section %s hash mismatch, expect %s, got %s", r.section, l.SHA256, hash)
+	}
+	r.section = ""
+	return "", nil, io.EOF
+}
+
+// Close - close the snapshot file.
+func (r *Reader) Close() error {
+	return r.f.Close()
+}
 
-	// reputaion has pure math model implementation and can be simply marshal to bytes out.
-	// reputation []byte
+// Verify - read through @p file, checking format version and hash of every section.
+func Verify(file string) error {
+	r, err := OpenReader(file)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	for {
+		_, err := r.NextSection()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for {
+			_, _, err := r.NextRow()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
 }
@@ -0,0 +1,130 @@
+package exporter
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRow struct {
+	table string
+	row   string
+}
+
+func writeSnapshot(t *testing.T, file string, sections map[string][]testRow, order []string) {
+	w, err := CreateWriter(file, 100, "lino-testnet")
+	assert.Nil(t, err)
+	for _, name := range order {
+		assert.Nil(t, w.BeginSection(name))
+		for _, row := range sections[name] {
+			assert.Nil(t, w.WriteRow(row.table, []byte(row.row)))
+		}
+		assert.Nil(t, w.EndSection())
+	}
+	assert.Nil(t, w.Close())
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lino-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "snapshot")
+
+	sections := map[string][]testRow{
+		AccountSection: {
+			{"accounts", `{"username":"<user1>"}`},
+			{"accounts", `{"username":"user2"}`},
+			{"account_grant_pub_keys", `{"username":"user1"}`},
+		},
+		PostSection: {},
+	}
+	order := []string{AccountSection, PostSection}
+	writeSnapshot(t, file, sections, order)
+	assert.Nil(t, Verify(file))
+
+	r, err := OpenReader(file)
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Equal(t, AppState{Version: SnapshotVersion, Height: 100, ChainID: "lino-testnet"}, r.Header())
+	for _, name := range order {
+		section, err := r.NextSection()
+		assert.Nil(t, err)
+		assert.Equal(t, name, section)
+		for _, expect := range sections[name] {
+			table, row, err := r.NextRow()
+			assert.Nil(t, err)
+			assert.Equal(t, expect.table, table)
+			assert.Equal(t, expect.row, string(row))
+		}
+		_, _, err = r.NextRow()
+		assert.Equal(t, io.EOF, err)
+	}
+	_, err = r.NextSection()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestSnapshotVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lino-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "snapshot")
+	sections := map[string][]testRow{
+		AccountSection: {{"accounts", `{"username":"user1"}`}},
+	}
+
+	testCases := []struct {
+		testName  string
+		modify    func(content string) string
+		expectErr bool
+	}{
+		{
+			testName:  "untouched snapshot",
+			modify:    func(content string) string { return content },
+			expectErr: false,
+		},
+		{
+			testName: "version mismatch",
+			modify: func(content string) string {
+				return strings.Replace(content, `"version":2`, `"version":1`, 1)
+			},
+			expectErr: true,
+		},
+		{
+			testName: "row changed",
+			modify: func(content string) string {
+				return strings.Replace(content, "user1", "user2", 1)
+			},
+			expectErr: true,
+		},
+		{
+			testName: "row removed",
+			modify: func(content string) string {
+				lines := strings.SplitAfter(content, "\n")
+				return strings.Join(append(lines[:2], lines[3:]...), "")
+			},
+			expectErr: true,
+		},
+		{
+			testName: "truncated",
+			modify: func(content string) string {
+				return content[:len(content)-10]
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		writeSnapshot(t, file, sections, []string{AccountSection})
+		content, err := ioutil.ReadFile(file)
+		assert.Nil(t, err)
+		assert.Nil(t, ioutil.WriteFile(file, []byte(tc.modify(string(content))), 0644))
+		err = Verify(file)
+		if tc.expectErr != (err != nil) {
+			t.Errorf("%s: diff err, expect err %v, got %v", tc.testName, tc.expectErr, err)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// TableDiff - differences of a table between a source snapshot and its replay.
+type TableDiff struct {
+	Section    string
+	Table      string
+	SourceRows int64
+	ReplayRows int64
+	// keys of rows only found in source.
+	Missing []string
+	// keys of rows only found in replay.
+	Extra []string
+	// rows found in both with different content.
+	Changed []RowDiff
+}
+
+// RowDiff - a row whose content changed, with the top level fields that differ.
+type RowDiff struct {
+	Key    string
+	Fields []string
+}
+
+// Equal - true if the table is the same in both snapshots.
+func (d TableDiff) Equal() bool {
+	return d.SourceRows == d.ReplayRows &&
+		len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Changed) == 0
+}
+
+// tableRows - rows of a table grouped by key.
+type tableRows struct {
+	count int64
+	rows  map[string][]json.RawMessage
+}
+
+// Diff - compare @p source and @p replay snapshot section by section, both
+// snapshots must have sections in the same order. Only one section of each
+// snapshot is held in memory at a time. Rows are matched by key, which is made
+// of the leading scalar fields of a row, e.g. the username of an account row.
+// Tables whose rows have no scalar leading field hold a single row with key "".
+func Diff(source, replay string) ([]TableDiff, error) {
+	src, err := OpenReader(source)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	rep, err := OpenReader(replay)
+	if err != nil {
+		return nil, err
+	}
+	defer rep.Close()
+
+	diffs := []TableDiff{}
+	for {
+		section, err := src.NextSection()
+		if err == io.EOF {
+			if section, err := rep.NextSection(); err != io.EOF {
+				return nil, fmt.Errorf("replay has extra section %s", section)
+			}
+			return diffs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		repSection, err := rep.NextSection()
+		if err == io.EOF {
+			return nil, fmt.Errorf("replay misses section %s", section)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if repSection != section {
+			return nil, fmt.Errorf("section order differs, expect %s, got %s", section, repSection)
+		}
+		srcTables, srcOrder, err := readSection(src)
+		if err != nil {
+			return nil, err
+		}
+		repTables, repOrder, err := readSection(rep)
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range repOrder {
+			if _, ok := srcTables[table]; !ok {
+				srcOrder = append(srcOrder, table)
+			}
+		}
+		for _, table := range srcOrder {
+			diff, err := diffTable(section, table, srcTables[table], repTables[table])
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		}
+	}
+}
+
+// readSection - read all rows of current section, grouped by table.
+func readSection(r *Reader) (map[string]*tableRows, []string, error) {
+	tables := map[string]*tableRows{}
+	order := []string{}
+	for {
+		table, row, err := r.NextRow()
+		if err == io.EOF {
+			return tables, order, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		key, err := rowKey(row)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid row in %s: %s", table, err)
+		}
+		t, ok := tables[table]
+		if !ok {
+			t = &tableRows{rows: map[string][]json.RawMessage{}}
+			tables[table] = t
+			order = append(order, table)
+		}
+		t.count++
+		t.rows[key] = append(t.rows[key], row)
+	}
+}
+
+// rowKey - join the leading scalar fields of a JSON object row.
+func rowKey(row json.RawMessage) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(row))
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", fmt.Errorf("row is not an object")
+	}
+	keys := []string{}
+	for dec.More() {
+		// field name
+		if _, err := dec.Token(); err != nil {
+			return "", err
+		}
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		if _, ok := tok.(json.Delim); ok {
+			break
+		}
+		keys = append(keys, fmt.Sprint(tok))
+	}
+	return strings.Join(keys, "/"), nil
+}
+
+func diffTable(section, table string, src, rep *tableRows) (TableDiff, error) {
+	diff := TableDiff{Section: section, Table: table}
+	if src == nil {
+		src = &tableRows{rows: map[string][]json.RawMessage{}}
+	}
+	if rep == nil {
+		rep = &tableRows{rows: map[string][]json.RawMessage{}}
+	}
+	diff.SourceRows = src.count
+	diff.ReplayRows = rep.count
+
+	keys := []string{}
+	for key := range src.rows {
+		keys = append(keys, key)
+	}
+	for key := range rep.rows {
+		if _, ok := src.rows[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		srcRows, repRows := unmatched(src.rows[key], rep.rows[key])
+		n := len(srcRows)
+		if len(repRows) < n {
+			n = len(repRows)
+		}
+		for i := 0; i < n; i++ {
+			fields, err := diffFields(srcRows[i], repRows[i])
+			if err != nil {
+				return diff, err
+			}
+			diff.Changed = append(diff.Changed, RowDiff{Key: key, Fields: fields})
+		}
+		for range srcRows[n:] {
+			diff.Missing = append(diff.Missing, key)
+		}
+		for range repRows[n:] {
+			diff.Extra = append(diff.Extra, key)
+		}
+	}
+	return diff, nil
+}
+
+// unmatched - drop rows that are in both @p a and @p b.
+func unmatched(a, b []json.RawMessage) ([]json.RawMessage, []json.RawMessage) {
+	restA := []json.RawMessage{}
+	restB := append([]json.RawMessage{}, b...)
+	for _, row := range a {
+		found := false
+		for i, other := range restB {
+			if bytes.Equal(row, other) {
+				restB = append(restB[:i], restB[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			restA = append(restA, row)
+		}
+	}
+	return restA, restB
+}
+
+// diffFields - names of top level fields that differ between two rows.
+func diffFields(a, b json.RawMessage) ([]string, error) {
+	fieldsA := map[string]json.RawMessage{}
+	fieldsB := map[string]json.RawMessage{}
+	if err := json.Unmarshal(a, &fieldsA); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &fieldsB); err != nil {
+		return nil, err
+	}
+	fields := []string{}
+	for name, value := range fieldsA {
+		if other, ok := fieldsB[name]; !ok || !bytes.Equal(value, other) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range fieldsB {
+		if _, ok := fieldsA[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
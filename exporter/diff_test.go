@@ -0,0 +1,135 @@
+package exporter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lino-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "source")
+	replay := filepath.Join(dir, "replay")
+
+	order := []string{AccountSection, VoterSection}
+	writeSnapshot(t, source, map[string][]testRow{
+		AccountSection: {
+			{"accounts", `{"username":"user1","bank":{"saving":"1"},"meta":{}}`},
+			{"accounts", `{"username":"user2","bank":{"saving":"2"},"meta":{}}`},
+			{"accounts", `{"username":"user3","bank":{"saving":"3"},"meta":{}}`},
+		},
+		VoterSection: {
+			{"delegations", `{"username":"user1","delegator":"user2","delegation":{"amount":"1"}}`},
+			{"reference_list", `{"list":{"all_validators":["user1"]}}`},
+		},
+	}, order)
+
+	testCases := []struct {
+		testName      string
+		sections      map[string][]testRow
+		expectEqual   []bool
+		expectChanged []RowDiff
+		expectMissing []string
+		expectExtra   []string
+	}{
+		{
+			testName: "same snapshot",
+			sections: map[string][]testRow{
+				AccountSection: {
+					{"accounts", `{"username":"user1","bank":{"saving":"1"},"meta":{}}`},
+					{"accounts", `{"username":"user2","bank":{"saving":"2"},"meta":{}}`},
+					{"accounts", `{"username":"user3","bank":{"saving":"3"},"meta":{}}`},
+				},
+				VoterSection: {
+					{"delegations", `{"username":"user1","delegator":"user2","delegation":{"amount":"1"}}`},
+					{"reference_list", `{"list":{"all_validators":["user1"]}}`},
+				},
+			},
+			expectEqual: []bool{true, true, true},
+		},
+		{
+			testName: "account changed, removed and added",
+			sections: map[string][]testRow{
+				AccountSection: {
+					{"accounts", `{"username":"user1","bank":{"saving":"2"},"meta":{}}`},
+					{"accounts", `{"username":"user2","bank":{"saving":"2"},"meta":{}}`},
+					{"accounts", `{"username":"user4","bank":{"saving":"3"},"meta":{}}`},
+				},
+				VoterSection: {
+					{"delegations", `{"username":"user1","delegator":"user2","delegation":{"amount":"1"}}`},
+					{"reference_list", `{"list":{"all_validators":["user1"]}}`},
+				},
+			},
+			expectEqual:   []bool{false, true, true},
+			expectChanged: []RowDiff{{Key: "user1", Fields: []string{"bank"}}},
+			expectMissing: []string{"user3"},
+			expectExtra:   []string{"user4"},
+		},
+		{
+			testName: "keyless table changed",
+			sections: map[string][]testRow{
+				AccountSection: {
+					{"accounts", `{"username":"user1","bank":{"saving":"1"},"meta":{}}`},
+					{"accounts", `{"username":"user2","bank":{"saving":"2"},"meta":{}}`},
+					{"accounts", `{"username":"user3","bank":{"saving":"3"},"meta":{}}`},
+				},
+				VoterSection: {
+					{"delegations", `{"username":"user1","delegator":"user2","delegation":{"amount":"1"}}`},
+					{"reference_list", `{"list":{"all_validators":[]}}`},
+				},
+			},
+			expectEqual:   []bool{true, true, false},
+			expectChanged: []RowDiff{{Key: "", Fields: []string{"list"}}},
+		},
+	}
+	for _, tc := range testCases {
+		writeSnapshot(t, replay, tc.sections, order)
+		diffs, err := Diff(source, replay)
+		if err != nil {
+			t.Errorf("%s: diff err, got %v", tc.testName, err)
+			continue
+		}
+		if len(diffs) != len(tc.expectEqual) {
+			t.Errorf("%s: diff tables, expect %d, got %d", tc.testName, len(tc.expectEqual), len(diffs))
+			continue
+		}
+		var changed []RowDiff
+		var missing, extra []string
+		for i, diff := range diffs {
+			if diff.Equal() != tc.expectEqual[i] {
+				t.Errorf("%s: diff equal of %s, expect %v, got %v",
+					tc.testName, diff.Table, tc.expectEqual[i], diff.Equal())
+			}
+			changed = append(changed, diff.Changed...)
+			missing = append(missing, diff.Missing...)
+			extra = append(extra, diff.Extra...)
+		}
+		assert.Equal(t, tc.expectChanged, changed, tc.testName)
+		assert.Equal(t, tc.expectMissing, missing, tc.testName)
+		assert.Equal(t, tc.expectExtra, extra, tc.testName)
+	}
+}
+
+func TestSnapshotDiffSectionMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lino-snapshot")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	source := filepath.Join(dir, "source")
+	replay := filepath.Join(dir, "replay")
+
+	sections := map[string][]testRow{
+		AccountSection: {{"accounts", `{"username":"user1"}`}},
+		VoterSection:   {},
+	}
+	writeSnapshot(t, source, sections, []string{AccountSection, VoterSection})
+	writeSnapshot(t, replay, sections, []string{AccountSection})
+	_, err = Diff(source, replay)
+	assert.NotNil(t, err)
+	_, err = Diff(replay, source)
+	assert.NotNil(t, err)
+}
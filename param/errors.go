@@ -133,6 +133,16 @@ func ErrFailedToUnmarshalAccountParam(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalAccountParam, fmt.Sprintf("failed to unmarshal account param: %s", err.Error()))
 }
 
+// ErrInfraParamNotFound - error when infra param is empty.
+func ErrInfraParamNotFound() sdk.Error {
+	return types.NewError(types.CodeInfraParamNotFound, fmt.Sprintf("infra param not found"))
+}
+
+// ErrFailedToUnmarshalInfraParam - error when unmarshal infra param failed.
+func ErrFailedToUnmarshalInfraParam(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalInfraParam, fmt.Sprintf("failed to unmarshal infra param: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalReputationParam - error when unmarshal reputation param failed.
 func ErrFailedToUnmarshalReputationParam(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReputationParam, fmt.Sprintf("failed to unmarshal account param: %s", err.Error()))
@@ -198,6 +208,36 @@ func ErrFailedToMarshalReputationParam(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalReputationParam, fmt.Sprintf("failed to marshal reputation param: %s", err.Error()))
 }
 
+// ErrFailedToMarshalInfraParam - error when marshal infra param failed.
+func ErrFailedToMarshalInfraParam(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalInfraParam, fmt.Sprintf("failed to marshal infra param: %s", err.Error()))
+}
+
+// ErrFeatureParamNotFound - error when feature param is empty.
+func ErrFeatureParamNotFound() sdk.Error {
+	return types.NewError(types.CodeFeatureParamNotFound, fmt.Sprintf("feature param not found"))
+}
+
+// ErrFailedToUnmarshalFeatureParam - error when unmarshal feature param failed.
+func ErrFailedToUnmarshalFeatureParam(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalFeatureParam, fmt.Sprintf("failed to unmarshal feature param: %s", err.Error()))
+}
+
+// ErrFailedToMarshalFeatureParam - error when marshal feature param failed.
+func ErrFailedToMarshalFeatureParam(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalFeatureParam, fmt.Sprintf("failed to marshal feature param: %s", err.Error()))
+}
+
+// ErrFeatureAlreadyActivated - error when activation of an active feature is changed.
+func ErrFeatureAlreadyActivated(name string) sdk.Error {
+	return types.NewError(types.CodeFeatureAlreadyActivated, fmt.Sprintf("feature %s is already activated", name))
+}
+
+// ErrFeatureActivationHeightPassed - error when a feature is activated at a past height.
+func ErrFeatureActivationHeightPassed(name string) sdk.Error {
+	return types.NewError(types.CodeFeatureActivationHeightPassed, fmt.Sprintf("activation height of feature %s has passed", name))
+}
+
 // ErrQueryFailed - error when query paramter store failed
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeParamQueryFailed, fmt.Sprintf("query paramter store failed"))
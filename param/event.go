@@ -1,6 +1,8 @@
 package param
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -23,6 +25,8 @@ func (cpe ChangeParamEvent) Execute(ctx sdk.Context, ph ParamHolder) sdk.Error {
 		return ph.setProposalParam(ctx, &parameter)
 	case DeveloperParam:
 		return ph.setDeveloperParam(ctx, &parameter)
+	case InfraParam:
+		return ph.setInfraParam(ctx, &parameter)
 	case ValidatorParam:
 		return ph.setValidatorParam(ctx, &parameter)
 	case BandwidthParam:
@@ -31,6 +35,13 @@ func (cpe ChangeParamEvent) Execute(ctx sdk.Context, ph ParamHolder) sdk.Error {
 		return ph.setAccountParam(ctx, &parameter)
 	case PostParam:
 		return ph.setPostParam(ctx, &parameter)
+	case FeatureParam:
+		// features may have been activated since the proposal was created
+		if err := ph.CheckFeatureParamChange(ctx, parameter); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("feature param change dropped: %s", err.Error()))
+			return nil
+		}
+		return ph.setFeatureParam(ctx, &parameter)
 	default:
 		return ErrInvalidaParameter()
 	}
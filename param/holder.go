@@ -20,6 +20,8 @@ var (
 	accountParamSubstore                 = []byte{0x09} // Substore for account param
 	postParamSubStore                    = []byte{0x0a} // Substore for evaluate of content value
 	reputationParamSubStore              = []byte{0x0b} // Substore for reputation parameters
+	infraParamSubStore                   = []byte{0x0c} // Substore for infra param
+	featureParamSubStore                 = []byte{0x0d} // Substore for feature activation param
 
 	// AnnualInflationCeiling - annual inflation upper bound
 	AnnualInflationCeiling = types.NewDecFromRat(98, 1000)
@@ -83,6 +85,15 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		return err
 	}
 
+	infraParam := &InfraParam{
+		InfraMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraCoinReturnTimes:       int64(7),
+	}
+	if err := ph.setInfraParam(ctx, infraParam); err != nil {
+		return err
+	}
+
 	validatorParam := &ValidatorParam{
 		ValidatorMinWithdraw:           types.NewCoinFromInt64(1 * types.Decimals),
 		ValidatorMinVotingDeposit:      types.NewCoinFromInt64(300000 * types.Decimals),
@@ -142,6 +153,8 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 		CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 		VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+		FeeEnabled:                  false,
+		MinGasPrice:                 types.NewDecFromRat(1, 10),
 	}
 	if err := ph.setBandwidthParam(ctx, bandwidthParam); err != nil {
 		return err
@@ -152,6 +165,7 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 		FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 		MaxNumFrozenMoney:            10,
+		MaxNumBalanceHistory:         1000,
 	}
 	if err := ph.setAccountParam(ctx, accountParam); err != nil {
 		return err
@@ -164,6 +178,11 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		return err
 	}
 
+	featureParam := DefaultFeatureParam()
+	if err := ph.setFeatureParam(ctx, &featureParam); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -180,7 +199,9 @@ func (ph ParamHolder) InitParamFromConfig(
 	coinDayParam CoinDayParam,
 	bandwidthParam BandwidthParam,
 	accParam AccountParam,
-	repParam ReputationParam) error {
+	repParam ReputationParam,
+	infraParam InfraParam,
+	featureParam FeatureParam) error {
 	if err := ph.setGlobalAllocationParam(ctx, &globalParam); err != nil {
 		return err
 	}
@@ -222,6 +243,14 @@ func (ph ParamHolder) InitParamFromConfig(
 		return err
 	}
 
+	if err := ph.setInfraParam(ctx, &infraParam); err != nil {
+		return err
+	}
+
+	if err := ph.setFeatureParam(ctx, &featureParam); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -283,6 +312,80 @@ func (ph ParamHolder) GetDeveloperParam(ctx sdk.Context) (*DeveloperParam, sdk.E
 	return param, nil
 }
 
+// GetInfraParam - get infra param
+func (ph ParamHolder) GetInfraParam(ctx sdk.Context) (*InfraParam, sdk.Error) {
+	store := ctx.KVStore(ph.key)
+	paramBytes := store.Get(GetInfraParamKey())
+	if paramBytes == nil {
+		return nil, ErrInfraParamNotFound()
+	}
+	param := new(InfraParam)
+	if err := ph.cdc.UnmarshalBinaryLengthPrefixed(paramBytes, param); err != nil {
+		return nil, ErrFailedToUnmarshalInfraParam(err)
+	}
+	return param, nil
+}
+
+// GetFeatureParam - get feature param
+func (ph ParamHolder) GetFeatureParam(ctx sdk.Context) (*FeatureParam, sdk.Error) {
+	store := ctx.KVStore(ph.key)
+	paramBytes := store.Get(GetFeatureParamKey())
+	if paramBytes == nil {
+		return nil, ErrFeatureParamNotFound()
+	}
+	param := new(FeatureParam)
+	if err := ph.cdc.UnmarshalBinaryLengthPrefixed(paramBytes, param); err != nil {
+		return nil, ErrFailedToUnmarshalFeatureParam(err)
+	}
+	return param, nil
+}
+
+// GetEffectiveFeatureParam - get feature param in effect,
+// chain started without feature param uses the default activation heights.
+func (ph ParamHolder) GetEffectiveFeatureParam(ctx sdk.Context) FeatureParam {
+	param, err := ph.GetFeatureParam(ctx)
+	if err != nil {
+		return DefaultFeatureParam()
+	}
+	return *param
+}
+
+// GetFeatureActivationHeight - get activation height of the feature, false if the feature is never active.
+func (ph ParamHolder) GetFeatureActivationHeight(ctx sdk.Context, name string) (int64, bool) {
+	return ph.GetEffectiveFeatureParam(ctx).GetActivationHeight(name)
+}
+
+// IsFeatureActive - check if the feature is active at current block height
+func (ph ParamHolder) IsFeatureActive(ctx sdk.Context, name string) bool {
+	height, ok := ph.GetFeatureActivationHeight(ctx, name)
+	return ok && ctx.BlockHeader().Height >= height
+}
+
+// CheckFeatureParamChange - check if the feature param can be changed to @p newParam,
+// a feature already active can't be changed or removed and no feature can be
+// activated at a passed height.
+func (ph ParamHolder) CheckFeatureParamChange(ctx sdk.Context, newParam FeatureParam) sdk.Error {
+	curParam := ph.GetEffectiveFeatureParam(ctx)
+	height := ctx.BlockHeader().Height
+	for _, feature := range curParam.Features {
+		if feature.Height > height {
+			continue
+		}
+		if newHeight, ok := newParam.GetActivationHeight(feature.Name); !ok || newHeight != feature.Height {
+			return ErrFeatureAlreadyActivated(feature.Name)
+		}
+	}
+	for _, feature := range newParam.Features {
+		if curHeight, ok := curParam.GetActivationHeight(feature.Name); ok && curHeight == feature.Height {
+			continue
+		}
+		if feature.Height < height {
+			return ErrFeatureActivationHeightPassed(feature.Name)
+		}
+	}
+	return nil
+}
+
 // GetVoteParam - get vote param
 func (ph ParamHolder) GetVoteParam(ctx sdk.Context) (*VoteParam, sdk.Error) {
 	store := ctx.KVStore(ph.key)
@@ -460,6 +563,26 @@ func (ph ParamHolder) setDeveloperParam(ctx sdk.Context, param *DeveloperParam)
 	return nil
 }
 
+func (ph ParamHolder) setInfraParam(ctx sdk.Context, param *InfraParam) sdk.Error {
+	store := ctx.KVStore(ph.key)
+	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(*param)
+	if err != nil {
+		return ErrFailedToMarshalInfraParam(err)
+	}
+	store.Set(GetInfraParamKey(), paramBytes)
+	return nil
+}
+
+func (ph ParamHolder) setFeatureParam(ctx sdk.Context, param *FeatureParam) sdk.Error {
+	store := ctx.KVStore(ph.key)
+	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(*param)
+	if err != nil {
+		return ErrFailedToMarshalFeatureParam(err)
+	}
+	store.Set(GetFeatureParamKey(), paramBytes)
+	return nil
+}
+
 func (ph ParamHolder) setVoteParam(ctx sdk.Context, param *VoteParam) sdk.Error {
 	store := ctx.KVStore(ph.key)
 	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(*param)
@@ -545,6 +668,11 @@ func GetDeveloperParamKey() []byte {
 	return developerParamSubStore
 }
 
+// GetInfraParamKey - "infra param substore"
+func GetInfraParamKey() []byte {
+	return infraParamSubStore
+}
+
 // GetVoteParamKey - "vote param substore"
 func GetVoteParamKey() []byte {
 	return voteParamSubStore
@@ -579,3 +707,8 @@ func GetAccountParamKey() []byte {
 func GetReputationParamKey() []byte {
 	return reputationParamSubStore
 }
+
+// GetFeatureParamKey - "feature param substore"
+func GetFeatureParamKey() []byte {
+	return featureParamSubStore
+}
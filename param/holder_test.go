@@ -165,6 +165,8 @@ func TestBandwidthParam(t *testing.T) {
 		SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 		CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 		VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+		FeeEnabled:                  false,
+		MinGasPrice:                 types.NewDecFromRat(1, 10),
 	}
 	err := ph.setBandwidthParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -174,6 +176,142 @@ func TestBandwidthParam(t *testing.T) {
 	assert.Equal(t, parameter, *resultPtr, "Bandwidth param should be equal")
 }
 
+func TestFeatureParam(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext().WithBlockHeader(abci.Header{Height: 100})
+
+	// chain without feature param uses default activation heights
+	height, ok := ph.GetFeatureActivationHeight(ctx, types.FeatureNoCostDonation)
+	assert.True(t, ok)
+	assert.Equal(t, int64(types.BlockchainUpgrade1Update1Height), height)
+	assert.False(t, ph.IsFeatureActive(ctx, types.FeatureNoCostDonation))
+
+	parameter := FeatureParam{
+		Features: []FeatureActivation{
+			{Name: types.FeatureNoCostDonation, Height: 100},
+			{Name: types.FeatureCoinReputationInput, Height: 101},
+		},
+	}
+	err := ph.setFeatureParam(ctx, &parameter)
+	assert.Nil(t, err)
+
+	resultPtr, err := ph.GetFeatureParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, parameter, *resultPtr, "Feature param should be equal")
+
+	assert.True(t, ph.IsFeatureActive(ctx, types.FeatureNoCostDonation))
+	assert.False(t, ph.IsFeatureActive(ctx, types.FeatureCoinReputationInput))
+	// feature not in param is never active
+	assert.False(t, ph.IsFeatureActive(ctx, types.FeatureGrowthRateCorrection))
+	_, ok = ph.GetFeatureActivationHeight(ctx, types.FeatureGrowthRateCorrection)
+	assert.False(t, ok)
+}
+
+func TestCheckFeatureParamChange(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext().WithBlockHeader(abci.Header{Height: 100})
+
+	curParam := FeatureParam{
+		Features: []FeatureActivation{
+			{Name: types.FeatureNoCostDonation, Height: 100},
+			{Name: types.FeatureCoinReputationInput, Height: 200},
+		},
+	}
+	assert.Nil(t, ph.setFeatureParam(ctx, &curParam))
+
+	testCases := []struct {
+		testName    string
+		newParam    FeatureParam
+		expectError sdk.Error
+	}{
+		{
+			testName:    "same param",
+			newParam:    curParam,
+			expectError: nil,
+		},
+		{
+			testName: "postpone feature not activated",
+			newParam: FeatureParam{
+				Features: []FeatureActivation{
+					{Name: types.FeatureNoCostDonation, Height: 100},
+					{Name: types.FeatureCoinReputationInput, Height: 300},
+				},
+			},
+			expectError: nil,
+		},
+		{
+			testName: "add feature at current height",
+			newParam: FeatureParam{
+				Features: []FeatureActivation{
+					{Name: types.FeatureNoCostDonation, Height: 100},
+					{Name: types.FeatureCoinReputationInput, Height: 200},
+					{Name: types.FeatureGrowthRateCorrection, Height: 100},
+				},
+			},
+			expectError: nil,
+		},
+		{
+			testName: "add feature at passed height",
+			newParam: FeatureParam{
+				Features: []FeatureActivation{
+					{Name: types.FeatureNoCostDonation, Height: 100},
+					{Name: types.FeatureCoinReputationInput, Height: 200},
+					{Name: types.FeatureGrowthRateCorrection, Height: 99},
+				},
+			},
+			expectError: ErrFeatureActivationHeightPassed(types.FeatureGrowthRateCorrection),
+		},
+		{
+			testName: "move feature not activated to passed height",
+			newParam: FeatureParam{
+				Features: []FeatureActivation{
+					{Name: types.FeatureNoCostDonation, Height: 100},
+					{Name: types.FeatureCoinReputationInput, Height: 50},
+				},
+			},
+			expectError: ErrFeatureActivationHeightPassed(types.FeatureCoinReputationInput),
+		},
+		{
+			testName: "change activated feature",
+			newParam: FeatureParam{
+				Features: []FeatureActivation{
+					{Name: types.FeatureNoCostDonation, Height: 300},
+					{Name: types.FeatureCoinReputationInput, Height: 200},
+				},
+			},
+			expectError: ErrFeatureAlreadyActivated(types.FeatureNoCostDonation),
+		},
+		{
+			testName: "remove activated feature",
+			newParam: FeatureParam{
+				Features: []FeatureActivation{
+					{Name: types.FeatureCoinReputationInput, Height: 200},
+				},
+			},
+			expectError: ErrFeatureAlreadyActivated(types.FeatureNoCostDonation),
+		},
+	}
+	for _, tc := range testCases {
+		err := ph.CheckFeatureParamChange(ctx, tc.newParam)
+		if !assert.Equal(t, tc.expectError, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectError)
+		}
+	}
+
+	// change param event doesn't rewrite activated feature
+	event := ChangeParamEvent{Param: testCases[5].newParam}
+	assert.Nil(t, event.Execute(ctx, ph))
+	resultPtr, err := ph.GetFeatureParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, curParam, *resultPtr)
+
+	event = ChangeParamEvent{Param: testCases[1].newParam}
+	assert.Nil(t, event.Execute(ctx, ph))
+	resultPtr, err = ph.GetFeatureParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, testCases[1].newParam, *resultPtr)
+}
+
 func TestAccountParam(t *testing.T) {
 	ph := NewParamHolder(TestKVStoreKey)
 	ctx := getContext()
@@ -182,6 +320,7 @@ func TestAccountParam(t *testing.T) {
 		RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 		FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 		MaxNumFrozenMoney:            10,
+		MaxNumBalanceHistory:         1000,
 	}
 	err := ph.setAccountParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -261,12 +400,15 @@ func TestInitParam(t *testing.T) {
 		SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 		CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 		VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+		FeeEnabled:                  false,
+		MinGasPrice:                 types.NewDecFromRat(1, 10),
 	}
 	accountParam := AccountParam{
 		MinimumBalance:               types.NewCoinFromInt64(0),
 		RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 		FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 		MaxNumFrozenMoney:            10,
+		MaxNumBalanceHistory:         1000,
 	}
 	postParam := PostParam{
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
@@ -276,6 +418,16 @@ func TestInitParam(t *testing.T) {
 	checkStorage(t, ctx, ph, globalAllocationParam, infraInternalAllocationParam,
 		developerParam, validatorParam, voteParam,
 		proposalParam, coinDayParam, bandwidthParam, accountParam, postParam)
+	infraParam, err := ph.GetInfraParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, InfraParam{
+		InfraMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraCoinReturnTimes:       int64(7),
+	}, *infraParam)
+	featureParam, err := ph.GetFeatureParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultFeatureParam(), *featureParam)
 }
 
 func TestInitParamFromConfig(t *testing.T) {
@@ -345,12 +497,15 @@ func TestInitParamFromConfig(t *testing.T) {
 		SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 		CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 		VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+		FeeEnabled:                  false,
+		MinGasPrice:                 types.NewDecFromRat(1, 10),
 	}
 	accountParam := AccountParam{
 		MinimumBalance:               types.NewCoinFromInt64(0),
 		RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 		FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 		MaxNumFrozenMoney:            10,
+		MaxNumBalanceHistory:         1000,
 	}
 	postParam := PostParam{
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
@@ -360,6 +515,14 @@ func TestInitParamFromConfig(t *testing.T) {
 	repParam := ReputationParam{
 		BestContentIndexN: 10,
 	}
+	infraParam := InfraParam{
+		InfraMinDeposit:            types.NewCoinFromInt64(1000 * types.Decimals),
+		InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraCoinReturnTimes:       int64(7),
+	}
+	featureParam := FeatureParam{
+		Features: []FeatureActivation{{Name: types.FeatureNoCostDonation, Height: 0}},
+	}
 
 	err := ph.InitParamFromConfig(
 		ctx, globalAllocationParam,
@@ -373,12 +536,20 @@ func TestInitParamFromConfig(t *testing.T) {
 		bandwidthParam,
 		accountParam,
 		repParam,
+		infraParam,
+		featureParam,
 	)
 	assert.Nil(t, err)
 
 	checkStorage(t, ctx, ph, globalAllocationParam, infraInternalAllocationParam,
 		developerParam, validatorParam, voteParam,
 		proposalParam, coinDayParam, bandwidthParam, accountParam, postParam)
+	storedInfraParam, err := ph.GetInfraParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, infraParam, *storedInfraParam)
+	storedFeatureParam, err := ph.GetFeatureParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, featureParam, *storedFeatureParam)
 }
 
 func checkStorage(t *testing.T, ctx sdk.Context, ph ParamHolder, expectGlobalAllocationParam GlobalAllocationParam,
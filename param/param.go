@@ -23,8 +23,8 @@ type GlobalAllocationParam struct {
 }
 
 // InfraInternalAllocationParam - infra internal allocation parameters
-// StorageAllocation - percentage for storage provider (not in use now)
-// CDNAllocation - percentage for CDN provider (not in use now)
+// StorageAllocation - percentage of infra inflation for storage provider
+// CDNAllocation - percentage of infra inflation for CDN provider
 type InfraInternalAllocationParam struct {
 	StorageAllocation sdk.Dec `json:"storage_allocation"`
 	CDNAllocation     sdk.Dec `json:"CDN_allocation"`
@@ -84,6 +84,16 @@ type DeveloperParam struct {
 	DeveloperCoinReturnTimes       int64      `json:"developer_coin_return_times"`
 }
 
+// InfraParam - infra provider parameters
+// InfraMinDeposit - minimum deposit to become an infra provider
+// InfraCoinReturnIntervalSec - when revoke, coin return to infra provider by coin return event
+// InfraCoinReturnTimes - when revoke, coin return to infra provider by coin return event
+type InfraParam struct {
+	InfraMinDeposit            types.Coin `json:"infra_min_deposit"`
+	InfraCoinReturnIntervalSec int64      `json:"infra_coin_return_interval_second"`
+	InfraCoinReturnTimes       int64      `json:"infra_coin_return_times"`
+}
+
 // ValidatorParam - validator parameters
 // ValidatorMinWithdraw - minimum withdraw requirement
 // ValidatorMinVotingDeposit - minimum voting deposit requirement for user wanna be validator
@@ -119,10 +129,14 @@ type CoinDayParam struct {
 // BandwidthParam - bandwidth parameters
 // SecondsToRecoverBandwidth - seconds for user tps capacity fully charged
 // CapacityUsagePerTransaction - capacity usage per transaction, dynamic changed based on traffic
+// FeeEnabled - whether a transaction can pay fee instead of consuming its signers' capacity
+// MinGasPrice - minimum fee for each coin of capacity a transaction would consume
 type BandwidthParam struct {
 	SecondsToRecoverBandwidth   int64      `json:"seconds_to_recover_bandwidth"`
 	CapacityUsagePerTransaction types.Coin `json:"capacity_usage_per_transaction"`
 	VirtualCoin                 types.Coin `json:"virtual_coin"`
+	FeeEnabled                  bool       `json:"fee_enabled"`
+	MinGasPrice                 sdk.Dec    `json:"min_gas_price"`
 }
 
 // AccountParam - account parameters
@@ -130,11 +144,13 @@ type BandwidthParam struct {
 // RegisterFee - register fee need to pay to developer inflation pool for each account registration
 // FirstDepositFullCoinDayLimit - when register account, some of coin day of register fee to newly open account will be fully charged
 // MaxNumFrozenMoney - the upper limit for each person's ongoing frozen money
+// MaxNumBalanceHistory - the number of latest balance history details kept for each person, 0 disables it
 type AccountParam struct {
 	MinimumBalance               types.Coin `json:"minimum_balance"`
 	RegisterFee                  types.Coin `json:"register_fee"`
 	FirstDepositFullCoinDayLimit types.Coin `json:"first_deposit_full_coin_day_limit"`
 	MaxNumFrozenMoney            int64      `json:"max_num_frozen_money"`
+	MaxNumBalanceHistory         int64      `json:"max_num_balance_history"`
 }
 
 // PostParam - post parameters
@@ -150,3 +166,46 @@ type PostParam struct {
 type ReputationParam struct {
 	BestContentIndexN int `json:"best_content_index_n"`
 }
+
+// FeatureParam - protocol feature activation parameters
+// Features - features and the block height since which each of them is active,
+// a feature not in the list is never active
+type FeatureParam struct {
+	Features []FeatureActivation `json:"features"`
+}
+
+// FeatureActivation - name of a feature and its activation height
+type FeatureActivation struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+}
+
+// GetActivationHeight - activation height of the feature, false if it's not in the param
+func (p FeatureParam) GetActivationHeight(name string) (int64, bool) {
+	for _, feature := range p.Features {
+		if feature.Name == name {
+			return feature.Height, true
+		}
+	}
+	return 0, false
+}
+
+// IsKnownFeature - check if the feature is implemented by this binary
+func IsKnownFeature(name string) bool {
+	_, ok := DefaultFeatureParam().GetActivationHeight(name)
+	return ok
+}
+
+// DefaultFeatureParam - activation heights of features on Lino Blockchain
+func DefaultFeatureParam() FeatureParam {
+	return FeatureParam{
+		Features: []FeatureActivation{
+			{Name: types.FeatureNoCostDonation, Height: types.BlockchainUpgrade1Update1Height},
+			{Name: types.FeatureGrowthRateCorrection, Height: types.BlockchainUpgrade1Update2Height},
+			{Name: types.FeatureRewardPoolAdjustment, Height: types.BlockchainUpgrade1Update3Height},
+			{Name: types.FeatureDonationBandwidthFix, Height: types.BlockchainUpgrade1Update4Height},
+			{Name: types.FeatureCoinReputationInput, Height: types.BlockchainUpgrade1Update5Height},
+			{Name: types.FeatureRepostDonationRedistribution, Height: types.BlockchainUpgrade1Update6Height},
+		},
+	}
+}
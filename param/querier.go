@@ -19,6 +19,7 @@ const (
 	QueryAllocationParam              = "allocation"
 	QueryInfraInternalAllocationParam = "infraInternal"
 	QueryDeveloperParam               = "developer"
+	QueryInfraParam                   = "infra"
 	QueryVoteParam                    = "vote"
 	QueryProposalParam                = "proposal"
 	QueryValidatorParam               = "validator"
@@ -27,6 +28,8 @@ const (
 	QueryAccountParam                 = "account"
 	QueryPostParam                    = "post"
 	QueryReputationParam              = "reputation"
+	QueryFeatureParam                 = "feature"
+	QueryActiveFeatures               = "activeFeatures"
 )
 
 // creates a querier for account REST endpoints
@@ -41,6 +44,8 @@ func NewQuerier(ph ParamHolder) sdk.Querier {
 			return queryInfraInternalAllocationParam(ctx, cdc, path[1:], req, ph)
 		case QueryDeveloperParam:
 			return queryDeveloperParam(ctx, cdc, path[1:], req, ph)
+		case QueryInfraParam:
+			return queryInfraParam(ctx, cdc, path[1:], req, ph)
 		case QueryVoteParam:
 			return queryVoteParam(ctx, cdc, path[1:], req, ph)
 		case QueryProposalParam:
@@ -55,6 +60,10 @@ func NewQuerier(ph ParamHolder) sdk.Querier {
 			return queryPostParam(ctx, cdc, path[1:], req, ph)
 		case QueryReputationParam:
 			return queryReputationParam(ctx, cdc, path[1:], req, ph)
+		case QueryFeatureParam:
+			return queryFeatureParam(ctx, cdc, path[1:], req, ph)
+		case QueryActiveFeatures:
+			return queryActiveFeatures(ctx, cdc, path[1:], req, ph)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown param query endpoint")
 		}
@@ -97,6 +106,18 @@ func queryDeveloperParam(ctx sdk.Context, cdc *wire.Codec, path []string, req ab
 	return res, nil
 }
 
+func queryInfraParam(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, ph ParamHolder) ([]byte, sdk.Error) {
+	infraParam, err := ph.GetInfraParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(infraParam)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
 func queryVoteParam(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, ph ParamHolder) ([]byte, sdk.Error) {
 	voteParam, err := ph.GetVoteParam(ctx)
 	if err != nil {
@@ -180,3 +201,28 @@ func queryReputationParam(ctx sdk.Context, cdc *wire.Codec, path []string, req a
 	}
 	return res, nil
 }
+
+func queryFeatureParam(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, ph ParamHolder) ([]byte, sdk.Error) {
+	featureParam := ph.GetEffectiveFeatureParam(ctx)
+	res, marshalErr := cdc.MarshalJSON(featureParam)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryActiveFeatures - features active at the latest block height
+func queryActiveFeatures(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, ph ParamHolder) ([]byte, sdk.Error) {
+	featureParam := ph.GetEffectiveFeatureParam(ctx)
+	activeFeatures := []FeatureActivation{}
+	for _, feature := range featureParam.Features {
+		if ctx.BlockHeader().Height >= feature.Height {
+			activeFeatures = append(activeFeatures, feature)
+		}
+	}
+	res, marshalErr := cdc.MarshalJSON(activeFeatures)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -30,6 +30,9 @@ type TransferDetailType int
 // indicates the type of punishment for oncall validators
 type PunishType int
 
+// indicates the service infra provider offers, storage and CDN can be combined
+type InfraType int
+
 // GetPostKey try to generate PostKey from types.AccountKey and PostID
 func GetPermlink(author AccountKey, postID string) Permlink {
 	return Permlink(string(author) + PermlinkSeparator + postID)
@@ -36,6 +36,20 @@ const (
 	ContentCensorship = ProposalType(1)
 	ProtocolUpgrade   = ProposalType(2)
 
+	// Features switched on by the feature param since their activation height
+	// FeatureNoCostDonation - donation >= NoTPSLimitDonationMin will not cost bandwidth.
+	FeatureNoCostDonation = "no-cost-donation"
+	// FeatureGrowthRateCorrection - global growth rate is corrected.
+	FeatureGrowthRateCorrection = "growth-rate-correction"
+	// FeatureRewardPoolAdjustment - reward pool is adjusted once at activation height.
+	FeatureRewardPoolAdjustment = "reward-pool-adjustment"
+	// FeatureDonationBandwidthFix - only valid donation amount is exempted from bandwidth check.
+	FeatureDonationBandwidthFix = "donation-bandwidth-fix"
+	// FeatureCoinReputationInput - use coin instead of coinday as input for reputation.
+	FeatureCoinReputationInput = "coin-reputation-input"
+	// FeatureRepostDonationRedistribution - donation to repost is redistributed to root source post.
+	FeatureRepostDonationRedistribution = "repost-donation-redistribution"
+
 	// Different donation types
 	DirectDeposit = DonationType(0)
 	Inflation     = DonationType(1)
@@ -55,6 +69,10 @@ const (
 	ProposalReturnCoin   = TransferDetailType(11)
 	GenesisCoin          = TransferDetailType(12)
 	ClaimInterest        = TransferDetailType(13)
+	VestingTransferIn    = TransferDetailType(14)
+	VestingTransferBack  = TransferDetailType(15)
+	PostReward           = TransferDetailType(16)
+	HourlyInflation      = TransferDetailType(17)
 
 	// Different possible outcomes
 	TransferOut      = TransferDetailType(20)
@@ -65,6 +83,9 @@ const (
 	DeveloperDeposit = TransferDetailType(25)
 	InfraDeposit     = TransferDetailType(26)
 	ProposalDeposit  = TransferDetailType(27)
+	VestingTransfer  = TransferDetailType(28)
+	TransactionFee   = TransferDetailType(29)
+	ValidatorPenalty = TransferDetailType(30)
 
 	// punishment type
 	UnknownPunish      = PunishType(0)
@@ -72,6 +93,11 @@ const (
 	PunishAbsentCommit = PunishType(2)
 	PunishDidntVote    = PunishType(3)
 
+	// infra provider type, a provider offering both is StorageAndCDNInfra
+	StorageInfra       = InfraType(1)
+	CDNInfra           = InfraType(2)
+	StorageAndCDNInfra = InfraType(3)
+
 	// UsernameReCheck - UsernameReCheck is used to check user registration
 	UsernameReCheck        = "^[a-z]([a-z0-9-\\.]){1,19}[a-z0-9]$"
 	IllegalUsernameReCheck = "^[a-z0-9\\.-]*([-\\.]){2,}[a-z0-9\\.-]*$"
@@ -85,6 +111,15 @@ const (
 	// MaximumMemoLength - maximum length of memo
 	MaximumMemoLength = 100
 
+	// MaximumMultisigPubKeys - maximum number of keys in a threshold multisig key
+	MaximumMultisigPubKeys = 10
+
+	// MaximumVestingTransferTimes - maximum number of pieces a vesting transfer is released in
+	MaximumVestingTransferTimes = 100
+
+	// MinimumVestingTransferAmount - minimum amount of a vesting transfer, in coin.
+	MinimumVestingTransferAmount = 100 * Decimals
+
 	// MaximumJSONMetaLength - maximum length of account JSON meta
 	MaximumJSONMetaLength = 500
 
@@ -127,18 +162,50 @@ const (
 	// MaximumNumOfLinks - maximum number of links per post
 	MaximumNumOfLinks = 10
 
+	// MaximumNumOfPostTags - maximum number of tags per post
+	MaximumNumOfPostTags = 5
+
+	// MaximumLengthOfPostTag - maximum length of post tag
+	MaximumLengthOfPostTag = 30
+
+	// MaximumNumOfPostRevisions - maximum number of revisions kept in post revision log,
+	// the oldest revision is dropped when the log is full
+	MaximumNumOfPostRevisions = 20
+
+	// MinimumSubscriptionIntervalSec - minimum interval between two subscription payments
+	MinimumSubscriptionIntervalSec = 24 * 3600
+
+	// MaximumNumOfSubscriptionPeriods - maximum number of payments of a subscription
+	MaximumNumOfSubscriptionPeriods = 120
+
 	// MaximumLengthOfDeveloperWebsite - maximum length of developer website
 	MaximumLengthOfDeveloperWebsite = 100
 
 	// MaximumLengthOfDeveloperDesctiption - maximum length of developer description
 	MaximumLengthOfDeveloperDesctiption = 1000
 
+	// MaximumLengthOfInfraWebsite - maximum length of infra provider website
+	MaximumLengthOfInfraWebsite = 100
+
+	// MaximumLengthOfInfraDescription - maximum length of infra provider description
+	MaximumLengthOfInfraDescription = 1000
+
+	// MaximumInfraUsagePerAttestation - maximum usage attested by one attest usage msg
+	MaximumInfraUsagePerAttestation = int64(1000000000000)
+
+	// MaximumInfraUsagePerPeriod - maximum usage of one infra type an app can attest
+	// for one infra provider between two infra inflation distributions
+	MaximumInfraUsagePerPeriod = int64(1000000000000000)
+
 	// MaximumLengthOfAppMetadata - maximum length of developer App meta data
 	MaximumLengthOfAppMetadata = 1000
 
 	// MaximumLengthOfProposalReason - maximum length of proposal reason
 	MaximumLengthOfProposalReason = 1000
 
+	// MaximumLengthOfUpgradeName - maximum length of protocol upgrade name
+	MaximumLengthOfUpgradeName = 64
+
 	// InitAccountWithFullCoinDayMemo - init account with full coin day memo
 	InitAccountWithFullCoinDayMemo = "open account deposit"
 
@@ -163,21 +230,31 @@ const (
 	// TendermintValidatorPower - every validator has const power in tendermint engine.
 	TendermintValidatorPower = 1000
 
-	// BlockchainUpgrade1Update1Height - since this height, donation > 1 will not cost bandwidth.
+	// BlockchainUpgrade1Update1Height - default activation height of FeatureNoCostDonation.
 	BlockchainUpgrade1Update1Height = 21610
 
-	// BlockchainUpgrade1Update2Height - global growth rate is corrected.
+	// BlockchainUpgrade1Update2Height - default activation height of FeatureGrowthRateCorrection.
 	BlockchainUpgrade1Update2Height = 146000
 
-	// BlockchainUpgrade1Update3Height - reward pool is adjusted.
+	// BlockchainUpgrade1Update3Height - default activation height of FeatureRewardPoolAdjustment.
 	BlockchainUpgrade1Update3Height = 148000
 
-	// BlockchainUpgrade1Update4Height - fix donation bandwidth check.
+	// BlockchainUpgrade1Update4Height - default activation height of FeatureDonationBandwidthFix.
 	BlockchainUpgrade1Update4Height = 386000
 
-	// BlockchainUpgrade1Update5Height - use coin instead of coinday as input for reputaion.
+	// BlockchainUpgrade1Update5Height - default activation height of FeatureCoinReputationInput.
 	BlockchainUpgrade1Update5Height = 680000
 
+	// BlockchainUpgrade1Update6Height - default activation height of FeatureRepostDonationRedistribution.
+	BlockchainUpgrade1Update6Height = 1200000
+
+	// ProtocolUpgradeName - name of the protocol upgrade implemented by this binary,
+	// the chain only resumes past the halt height of an upgrade plan with the same name.
+	ProtocolUpgradeName = "upgrade1"
+
 	// NoTPSLimitDonationMin - donation >= this value will not cost bandwidth, in coin.
 	NoTPSLimitDonationMin = 100000
+
+	// TxFeeDenom - denom of transaction fee in StdFee, amount in coin.
+	TxFeeDenom = "linocoin"
 )
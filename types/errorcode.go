@@ -30,6 +30,7 @@ const (
 	CodeWrongNumberOfSigners sdk.CodeType = 153
 	CodeInvalidSequence      sdk.CodeType = 154
 	CodeUnverifiedBytes      sdk.CodeType = 155
+	CodeUnverifiedAppSig     sdk.CodeType = 157
 
 	// ABCI Response Codes
 	CodeGenesisFailed sdk.CodeType = 200
@@ -103,6 +104,14 @@ const (
 	CodeUpdateLastPostAt                     sdk.CodeType = 361
 	CodeFrozenMoneyListTooLong               sdk.CodeType = 362
 	CodeAccountQueryFailed                   sdk.CodeType = 363
+	CodeBalanceHistoryNotFound               sdk.CodeType = 364
+	CodeVestingTransferNotFound              sdk.CodeType = 365
+	CodeFailedToMarshalVestingTransfer       sdk.CodeType = 366
+	CodeFailedToUnmarshalVestingTransfer     sdk.CodeType = 367
+	CodeInvalidVestingSchedule               sdk.CodeType = 368
+	CodeInvalidMultisigPubKey                sdk.CodeType = 369
+	CodeVestingTransferAmountTooLow          sdk.CodeType = 370
+	CodeVestingTransferToSelf                sdk.CodeType = 371
 
 	// Lino post errors reserve 400 ~ 499
 	CodePostMetaNotFound                     sdk.CodeType = 400
@@ -147,6 +156,19 @@ const (
 	CodeGetSourcePost                        sdk.CodeType = 439
 	CodePostTooOften                         sdk.CodeType = 440
 	CodePostQueryFailed                      sdk.CodeType = 441
+	CodeAppMismatch                          sdk.CodeType = 442
+	CodeTooManyPostTags                      sdk.CodeType = 443
+	CodeInvalidPostTag                       sdk.CodeType = 444
+	CodeDuplicatePostTag                     sdk.CodeType = 445
+	CodePostRevisionNotFound                 sdk.CodeType = 446
+	CodeFailedToMarshalPostRevision          sdk.CodeType = 447
+	CodeFailedToUnmarshalPostRevision        sdk.CodeType = 448
+	CodeInvalidSubscriptionInterval          sdk.CodeType = 449
+	CodeInvalidSubscriptionPeriods           sdk.CodeType = 450
+	CodeSubscriptionAlreadyExist             sdk.CodeType = 451
+	CodeSubscriptionNotFound                 sdk.CodeType = 452
+	CodeFailedToMarshalSubscription          sdk.CodeType = 453
+	CodeFailedToUnmarshalSubscription        sdk.CodeType = 454
 
 	// Lino validator errors reserve 500 ~ 599
 	CodeValidatorNotFound              sdk.CodeType = 500
@@ -188,6 +210,8 @@ const (
 	CodePastDayIsNegative                      sdk.CodeType = 625
 	CodeFailedToParseEventCacheList            sdk.CodeType = 626
 	CodeGlobalQueryFailed                      sdk.CodeType = 627
+	CodeFailedToMarshalBlockJournal            sdk.CodeType = 628
+	CodeFailedToUnmarshalBlockJournal          sdk.CodeType = 629
 
 	// Vote errors reserve 700 ~ 799
 	CodeVoterNotFound                  sdk.CodeType = 700
@@ -205,6 +229,10 @@ const (
 	CodeValidatorCannotRevoke          sdk.CodeType = 712
 	CodeVoteAlreadyExist               sdk.CodeType = 713
 	CodeVoteQueryFailed                sdk.CodeType = 714
+	CodeFailedToMarshalVoteOverride    sdk.CodeType = 715
+	CodeFailedToUnmarshalVoteOverride  sdk.CodeType = 716
+	CodeFailedToMarshalTotalStake      sdk.CodeType = 717
+	CodeFailedToUnmarshalTotalStake    sdk.CodeType = 718
 
 	// Lino infra errors reserve 800 ~ 899
 	CodeInfraProviderNotFound              sdk.CodeType = 800
@@ -215,6 +243,18 @@ const (
 	CodeFailedToUnmarshalInfraProviderList sdk.CodeType = 805
 	CodeInvalidUsage                       sdk.CodeType = 806
 	CodeInfraQueryFailed                   sdk.CodeType = 807
+	CodeInfraProviderAlreadyExist          sdk.CodeType = 808
+	CodeInsufficientInfraDeposit           sdk.CodeType = 809
+	CodeInvalidInfraWebsite                sdk.CodeType = 810
+	CodeInvalidInfraDescription            sdk.CodeType = 811
+	CodeInfraUsageNotFound                 sdk.CodeType = 812
+	CodeFailedToMarshalInfraUsage          sdk.CodeType = 813
+	CodeFailedToUnmarshalInfraUsage        sdk.CodeType = 814
+	CodeInfraDeveloperNotFound             sdk.CodeType = 815
+	CodeCannotAttestOwnUsage               sdk.CodeType = 816
+	CodeInvalidInfraType                   sdk.CodeType = 817
+	CodeInfraTypeNotProvided               sdk.CodeType = 818
+	CodeInfraUsageExceedsLimit             sdk.CodeType = 819
 
 	// Lino developer errors reserve 900 ~ 999
 	CodeDeveloperListNotFound          sdk.CodeType = 900
@@ -273,6 +313,14 @@ const (
 	CodeFailedToUnmarshalReputationParam              sdk.CodeType = 1036
 	CodeReputationParamNotFound                       sdk.CodeType = 1037
 	CodeParamQueryFailed                              sdk.CodeType = 1038
+	CodeInfraParamNotFound                            sdk.CodeType = 1039
+	CodeFailedToUnmarshalInfraParam                   sdk.CodeType = 1040
+	CodeFailedToMarshalInfraParam                     sdk.CodeType = 1041
+	CodeFeatureParamNotFound                          sdk.CodeType = 1042
+	CodeFailedToUnmarshalFeatureParam                 sdk.CodeType = 1043
+	CodeFailedToMarshalFeatureParam                   sdk.CodeType = 1044
+	CodeFeatureAlreadyActivated                       sdk.CodeType = 1045
+	CodeFeatureActivationHeightPassed                 sdk.CodeType = 1046
 
 	// Proposal errors reserve 1100 ~ 1199
 	CodeOngoingProposalNotFound         sdk.CodeType = 1100
@@ -294,6 +342,13 @@ const (
 	CodeIllegalParameter                sdk.CodeType = 1116
 	CodeReasonTooLong                   sdk.CodeType = 1117
 	CodeProposalQueryFailed             sdk.CodeType = 1118
+	CodeInvalidCensorshipRevision       sdk.CodeType = 1119
+	CodeInvalidUpgradeName              sdk.CodeType = 1120
+	CodeInvalidUpgradeHeight            sdk.CodeType = 1121
+	CodeUpgradePlanNotFound             sdk.CodeType = 1122
+	CodeFailedToMarshalUpgradePlan      sdk.CodeType = 1123
+	CodeFailedToUnmarshalUpgradePlan    sdk.CodeType = 1124
+	CodeUpgradePlanAlreadyExist         sdk.CodeType = 1125
 
 	// reputation errors reserve 1200 ~ 1299
 	CodeReputationQueryFailed sdk.CodeType = 1200
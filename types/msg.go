@@ -17,3 +17,27 @@ type Msg interface {
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterInterface((*Msg)(nil), nil)
 }
+
+// AppMsg - msg sent through an app, app co-signature of the transaction
+// is verified against app key of the app named by the msg.
+type AppMsg interface {
+	GetFromApp() AccountKey
+}
+
+type contextKey int
+
+const appContextKey contextKey = iota
+
+// WithApp - return @p ctx carrying the app whose co-signature on the transaction is verified.
+func WithApp(ctx sdk.Context, app AccountKey) sdk.Context {
+	return ctx.WithValue(appContextKey, app)
+}
+
+// GetApp - return the app verified to co-sign the transaction, empty if not co-signed.
+func GetApp(ctx sdk.Context) AccountKey {
+	app, ok := ctx.Value(appContextKey).(AccountKey)
+	if !ok {
+		return ""
+	}
+	return app
+}
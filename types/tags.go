@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCI tag keys attached to message handler results and time event executions,
+// so that transactions and blocks can be searched by Tendermint's tx indexer.
+const (
+	TagSender     = "sender"
+	TagReceiver   = "receiver"
+	TagPermlink   = "permlink"
+	TagProposalID = "proposal_id"
+	TagAmount     = "amount"
+	TagDetailType = "detail_type"
+	TagEvent      = "event"
+)
+
+// NewAccountTags - tags of a message sent by @p sender.
+func NewAccountTags(sender AccountKey) sdk.Tags {
+	return sdk.NewTags(TagSender, string(sender))
+}
+
+// NewTransferTags - tags of @p amount moved from @p sender to @p receiver,
+// empty sender or receiver is omitted.
+func NewTransferTags(
+	sender, receiver AccountKey, amount Coin, detailType TransferDetailType) sdk.Tags {
+	tags := sdk.EmptyTags()
+	if sender != "" {
+		tags = tags.AppendTag(TagSender, string(sender))
+	}
+	if receiver != "" {
+		tags = tags.AppendTag(TagReceiver, string(receiver))
+	}
+	return tags.AppendTag(TagAmount, amount.Amount.String()).
+		AppendTag(TagDetailType, strconv.Itoa(int(detailType)))
+}
+
+// NewPostTags - tags of a message sent by @p sender to post @p permlink.
+func NewPostTags(sender AccountKey, permlink Permlink) sdk.Tags {
+	return sdk.NewTags(TagSender, string(sender), TagPermlink, string(permlink))
+}
+
+// NewProposalTags - tags of a message sent by @p sender to proposal @p proposalID.
+func NewProposalTags(sender AccountKey, proposalID ProposalKey) sdk.Tags {
+	return sdk.NewTags(TagSender, string(sender), TagProposalID, string(proposalID))
+}
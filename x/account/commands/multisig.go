@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+
+	"github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
+// MultisigPubKeyCmd prints the threshold multisig public key made of the given public keys
+func MultisigPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-pub-key",
+		Short: "Create a threshold multisig public key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubKey, err := getMultisigPubKey()
+			if err != nil {
+				return err
+			}
+			if pubKey == nil {
+				return errors.Errorf("must provide --%s and --%s",
+					client.FlagMultisigThreshold, client.FlagMultisigPubKeys)
+			}
+			fmt.Println("multisig public key is:", strings.ToUpper(hex.EncodeToString(pubKey.Bytes())))
+			return nil
+		},
+	}
+	addMultisigFlags(cmd)
+	return cmd
+}
+
+func addMultisigFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(client.FlagMultisigThreshold, 0, "number of signatures required by multisig transaction key")
+	cmd.Flags().String(client.FlagMultisigPubKeys, "", "comma separated public keys of multisig transaction key")
+}
+
+// getMultisigPubKey - threshold multisig key from flags, nil if no threshold is given.
+func getMultisigPubKey() (crypto.PubKey, error) {
+	threshold := viper.GetInt(client.FlagMultisigThreshold)
+	if threshold == 0 {
+		return nil, nil
+	}
+	pubKeys := []crypto.PubKey{}
+	for _, str := range strings.Split(viper.GetString(client.FlagMultisigPubKeys), ",") {
+		bz, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := cryptoAmino.PubKeyFromBytes(bz)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if threshold < 0 || threshold > len(pubKeys) {
+		return nil, errors.Errorf("threshold %d is not reachable by %d keys", threshold, len(pubKeys))
+	}
+	return multisig.NewPubKeyMultisigThreshold(threshold, pubKeys), nil
+}
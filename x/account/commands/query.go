@@ -6,11 +6,13 @@ import (
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/account/model"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetBankCmd returns a query bank that will display the
@@ -55,6 +57,36 @@ func GetAccountsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetBalanceHistoryCmd returns a query balance history that will page through
+// balance changes of the given username, newest first
+func GetBalanceHistoryCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "balance-history <username>",
+		Short: "Query balance history",
+		RunE:  cmdr.getBalanceHistoryCmd,
+	}
+	cmd.Flags().Int64(client.FlagBefore, 0, "show details recorded before this sequence, the next of previous page")
+	cmd.Flags().Int64(client.FlagLimit, acc.MaxBalanceHistoryPageSize, "max number of details in a page")
+	return cmd
+}
+
+// GetVestingTransfersCmd returns a query of all ongoing vesting transfers sent by a user
+func GetVestingTransfersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "vesting-transfers <sender>",
+		Short: "Query ongoing vesting transfers",
+		RunE:  cmdr.getVestingTransfersCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -150,3 +182,48 @@ func (c commander) getAccountsCmd(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+func (c commander) getBalanceHistoryCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.QueryRoute(fmt.Sprintf("%s/%s/%s/%d/%d",
+		acc.QuerierRoute, acc.QueryAccountBalanceHistory, args[0],
+		viper.GetInt64(client.FlagBefore), viper.GetInt64(client.FlagLimit)))
+	if err != nil {
+		return err
+	}
+	page := new(model.BalanceHistoryPage)
+	if err := c.cdc.UnmarshalJSON(res, page); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(page); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getVestingTransfersCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+
+	res, err := ctx.QueryRoute(fmt.Sprintf("%s/%s/%s",
+		acc.QuerierRoute, acc.QueryVestingTransfers, args[0]))
+	if err != nil {
+		return err
+	}
+	transfers := []model.VestingTransfer{}
+	if err := c.cdc.UnmarshalJSON(res, &transfers); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(transfers); err != nil {
+		return err
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ func RecoverTxCmd(cdc *wire.Codec) *cobra.Command {
 		RunE:  sendRecoverTx(cdc),
 	}
 	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	addMultisigFlags(cmd)
 	return cmd
 }
 
@@ -37,11 +38,18 @@ func sendRecoverTx(cdc *wire.Codec) client.CommandTxCallback {
 		transactionPriv := secp256k1.GenPrivKey()
 		appPriv := secp256k1.GenPrivKey()
 		fmt.Println("new reset private key is:", strings.ToUpper(hex.EncodeToString(resetPriv.Bytes())))
-		fmt.Println("new transaction private key is:", strings.ToUpper(hex.EncodeToString(transactionPriv.Bytes())))
+		transactionPubKey, err := getMultisigPubKey()
+		if err != nil {
+			return err
+		}
+		if transactionPubKey == nil {
+			transactionPubKey = transactionPriv.PubKey()
+			fmt.Println("new transaction private key is:", strings.ToUpper(hex.EncodeToString(transactionPriv.Bytes())))
+		}
 		fmt.Println("new app private key is:", strings.ToUpper(hex.EncodeToString(appPriv.Bytes())))
 
 		// create the message
-		msg := acc.NewRecoverMsg(name, resetPriv.PubKey(), transactionPriv.PubKey(), appPriv.PubKey())
+		msg := acc.NewRecoverMsg(name, resetPriv.PubKey(), transactionPubKey, appPriv.PubKey())
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -30,6 +30,7 @@ func RegisterTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagReferrer, "", "referrer who spends money to open account")
 	cmd.Flags().String(client.FlagUser, "", "register user")
 	cmd.Flags().String(client.FlagAmount, "", "amount to register new user")
+	addMultisigFlags(cmd)
 	return cmd
 }
 
@@ -46,13 +47,20 @@ func sendRegisterTx(cdc *wire.Codec) client.CommandTxCallback {
 		appPriv := secp256k1.GenPrivKey()
 
 		fmt.Println("reset private key is:", strings.ToUpper(hex.EncodeToString(resetPriv.Bytes())))
-		fmt.Println("transaction private key is:", strings.ToUpper(hex.EncodeToString(transactionPriv.Bytes())))
+		transactionPubKey, err := getMultisigPubKey()
+		if err != nil {
+			return err
+		}
+		if transactionPubKey == nil {
+			transactionPubKey = transactionPriv.PubKey()
+			fmt.Println("transaction private key is:", strings.ToUpper(hex.EncodeToString(transactionPriv.Bytes())))
+		}
 		fmt.Println("app private key is:", strings.ToUpper(hex.EncodeToString(appPriv.Bytes())))
 
 		// // create the message
 		msg := acc.NewRegisterMsg(
 			referrer, name, types.LNO(amount),
-			resetPriv.PubKey(), transactionPriv.PubKey(), appPriv.PubKey())
+			resetPriv.PubKey(), transactionPubKey, appPriv.PubKey())
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+)
+
+// VestingTransferTxCmd will create a vesting transfer tx and sign it with the given key
+func VestingTransferTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-transfer",
+		Short: "Create and sign a transfer tx released to receiver over time",
+		RunE:  sendVestingTransferTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSender, "", "money sender")
+	cmd.Flags().String(client.FlagReceiver, "", "receiver username")
+	cmd.Flags().String(client.FlagAmount, "", "amount to transfer")
+	cmd.Flags().Int64(client.FlagTimes, 1, "number of pieces the amount is released in")
+	cmd.Flags().Int64(client.FlagInterval, 0, "seconds between two pieces")
+	cmd.Flags().String(client.FlagMemo, "", "memo msg")
+	return cmd
+}
+
+// CancelVestingTransferTxCmd will create a cancel vesting transfer tx and sign it with the given key
+func CancelVestingTransferTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-vesting-transfer",
+		Short: "Create and sign a tx to take back unreleased money of a vesting transfer",
+		RunE:  sendCancelVestingTransferTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSender, "", "money sender")
+	cmd.Flags().Int64(client.FlagVesting, 0, "id of the vesting transfer")
+	return cmd
+}
+
+// send vesting transfer transaction to the blockchain
+func sendVestingTransferTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := acc.NewVestingTransferMsg(
+			viper.GetString(client.FlagSender), viper.GetString(client.FlagReceiver),
+			types.LNO(viper.GetString(client.FlagAmount)), viper.GetInt64(client.FlagTimes),
+			viper.GetInt64(client.FlagInterval), viper.GetString(client.FlagMemo))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// send cancel vesting transfer transaction to the blockchain
+func sendCancelVestingTransferTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := acc.NewCancelVestingTransferMsg(
+			viper.GetString(client.FlagSender), viper.GetInt64(client.FlagVesting))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
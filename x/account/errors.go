@@ -195,6 +195,26 @@ func ErrInvalidUsername(msg string) sdk.Error {
 	return types.NewError(types.CodeInvalidUsername, msg)
 }
 
+// ErrInvalidVestingSchedule - error when times or interval of vesting transfer is invalid
+func ErrInvalidVestingSchedule(msg string) sdk.Error {
+	return types.NewError(types.CodeInvalidVestingSchedule, fmt.Sprintf("invalid vesting schedule: %s", msg))
+}
+
+// ErrVestingTransferAmountTooLow - error when vesting transfer amount is less than minimum
+func ErrVestingTransferAmountTooLow() sdk.Error {
+	return types.NewError(types.CodeVestingTransferAmountTooLow, fmt.Sprintf("vesting transfer amount is too low"))
+}
+
+// ErrVestingTransferToSelf - error when sender of vesting transfer is the receiver
+func ErrVestingTransferToSelf() sdk.Error {
+	return types.NewError(types.CodeVestingTransferToSelf, fmt.Sprintf("can't vesting transfer to self"))
+}
+
+// ErrInvalidMultisigPubKey - error when threshold multisig public key is invalid
+func ErrInvalidMultisigPubKey(msg string) sdk.Error {
+	return types.NewError(types.CodeInvalidMultisigPubKey, fmt.Sprintf("invalid multisig public key: %s", msg))
+}
+
 // ErrInvalidMemo - error when memo is invalid (length too long)
 func ErrInvalidMemo() sdk.Error {
 	return types.NewError(types.CodeInvalidMemo, fmt.Sprintf("invalid memo"))
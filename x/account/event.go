@@ -46,3 +46,38 @@ func CreateCoinReturnEvents(
 	}
 	return events, nil
 }
+
+// VestingReleaseEvent - release a piece of vesting transfer to its receiver
+type VestingReleaseEvent struct {
+	Sender    types.AccountKey `json:"sender"`
+	Receiver  types.AccountKey `json:"receiver"`
+	VestingID int64            `json:"vesting_id"`
+	Amount    types.Coin       `json:"amount"`
+}
+
+// Execute - execute vesting release event, return the coin released to receiver.
+func (event VestingReleaseEvent) Execute(ctx sdk.Context, am AccountManager) (types.Coin, sdk.Error) {
+	return am.ReleaseVestingTransfer(ctx, event.Sender, event.VestingID, event.Amount)
+}
+
+// CreateVestingReleaseEvents - create vesting release events, coin is split
+// into pieces the same way as coin return events.
+func CreateVestingReleaseEvents(
+	ctx sdk.Context, sender, receiver types.AccountKey, id int64, times int64,
+	coin types.Coin) ([]types.Event, sdk.Error) {
+	events := []types.Event{}
+	for i := int64(0); i < times; i++ {
+		pieceRat := coin.ToDec().Quo(sdk.NewDec(times - i))
+		piece := types.DecToCoin(pieceRat)
+		coin = coin.Minus(piece)
+
+		event := VestingReleaseEvent{
+			Sender:    sender,
+			Receiver:  receiver,
+			VestingID: id,
+			Amount:    piece,
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -24,6 +24,10 @@ func NewHandler(am AccountManager, gm *global.GlobalManager) sdk.Handler {
 			return handleRegisterMsg(ctx, am, gm, msg)
 		case UpdateAccountMsg:
 			return handleUpdateAccountMsg(ctx, am, msg)
+		case VestingTransferMsg:
+			return handleVestingTransferMsg(ctx, am, gm, msg)
+		case CancelVestingTransferMsg:
+			return handleCancelVestingTransferMsg(ctx, am, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized account msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -54,7 +58,7 @@ func handleTransferMsg(ctx sdk.Context, am AccountManager, msg TransferMsg) sdk.
 		ctx, msg.Receiver, coin, msg.Sender, msg.Memo, types.TransferIn); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Sender, msg.Receiver, coin, types.TransferOut)}
 }
 
 func handleClaimMsg(ctx sdk.Context, am AccountManager, msg ClaimMsg) sdk.Result {
@@ -62,7 +66,7 @@ func handleClaimMsg(ctx sdk.Context, am AccountManager, msg ClaimMsg) sdk.Result
 	if err := am.ClaimReward(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Username)}
 }
 
 func handleRecoverMsg(ctx sdk.Context, am AccountManager, msg RecoverMsg) sdk.Result {
@@ -75,7 +79,7 @@ func handleRecoverMsg(ctx sdk.Context, am AccountManager, msg RecoverMsg) sdk.Re
 		msg.NewAppPubKey); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Username)}
 }
 
 // Handle RegisterMsg
@@ -108,7 +112,7 @@ func handleRegisterMsg(ctx sdk.Context, am AccountManager, gm *global.GlobalMana
 		msg.NewAppPubKey, coin.Minus(accParams.RegisterFee)); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Referrer, msg.NewUser, coin, types.TransferOut)}
 }
 
 // Handle RegisterMsg
@@ -119,5 +123,48 @@ func handleUpdateAccountMsg(ctx sdk.Context, am AccountManager, msg UpdateAccoun
 	if err := am.UpdateJSONMeta(ctx, msg.Username, msg.JSONMeta); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Username)}
+}
+
+// Handle VestingTransferMsg
+func handleVestingTransferMsg(
+	ctx sdk.Context, am AccountManager, gm *global.GlobalManager, msg VestingTransferMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Receiver) {
+		return ErrReceiverNotFound(msg.Receiver).Result()
+	}
+
+	if !am.DoesAccountExist(ctx, msg.Sender) {
+		return ErrSenderNotFound(msg.Sender).Result()
+	}
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	transfer, err := am.AddVestingTransfer(
+		ctx, msg.Sender, msg.Receiver, coin, msg.IntervalSec, msg.Times, msg.Memo)
+	if err != nil {
+		return err.Result()
+	}
+
+	events, err := CreateVestingReleaseEvents(
+		ctx, msg.Sender, msg.Receiver, transfer.ID, msg.Times, coin)
+	if err != nil {
+		return err.Result()
+	}
+	if err := gm.RegisterCoinReturnEvent(ctx, events, msg.Times, msg.IntervalSec); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Sender, msg.Receiver, coin, types.VestingTransfer)}
+}
+
+// Handle CancelVestingTransferMsg
+func handleCancelVestingTransferMsg(ctx sdk.Context, am AccountManager, msg CancelVestingTransferMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Sender) {
+		return ErrSenderNotFound(msg.Sender).Result()
+	}
+	// pending release events of a cancelled transfer release nothing.
+	if err := am.CancelVestingTransfer(ctx, msg.Sender, msg.VestingID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Sender)}
 }
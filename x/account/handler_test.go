@@ -150,8 +150,9 @@ func TestHandleAccountRecover(t *testing.T) {
 	for testName, tc := range testCases {
 		msg := NewRecoverMsg(tc.user, tc.newResetKey, tc.newTransactionKey, tc.newAppKey)
 		result := handler(ctx, msg)
-		if !assert.Equal(t, sdk.Result{}, result) {
-			t.Errorf("%s: diff result, got %v, want %v", testName, result, sdk.Result{})
+		wantResult := sdk.Result{Tags: types.NewAccountTags(types.AccountKey(tc.user))}
+		if !assert.Equal(t, wantResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", testName, result, wantResult)
 		}
 
 		accInfo := model.AccountInfo{
@@ -199,7 +200,8 @@ func TestHandleRegister(t *testing.T) {
 				secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(),
 			),
-			expectResult:            sdk.Result{},
+			expectResult: sdk.Result{Tags: types.NewTransferTags(
+				"referrer", "user1", types.NewCoinFromInt64(1*types.Decimals), types.TransferOut)},
 			expectReferrerSaving:    c100,
 			expectNewAccountSaving:  c0,
 			expectNewAccountCoinDay: c0,
@@ -251,7 +253,8 @@ func TestHandleRegister(t *testing.T) {
 				secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(),
 			),
-			expectResult:            sdk.Result{},
+			expectResult: sdk.Result{Tags: types.NewTransferTags(
+				"referrer", "user3", types.NewCoinFromInt64(150000), types.TransferOut)},
 			expectReferrerSaving:    types.NewCoinFromInt64(9750000),
 			expectNewAccountSaving:  types.NewCoinFromInt64(50000),
 			expectNewAccountCoinDay: types.NewCoinFromInt64(50000),
@@ -264,7 +267,8 @@ func TestHandleRegister(t *testing.T) {
 				secp256k1.GenPrivKey().PubKey(),
 				secp256k1.GenPrivKey().PubKey(),
 			),
-			expectResult:            sdk.Result{},
+			expectResult: sdk.Result{Tags: types.NewTransferTags(
+				"referrer", "user4", types.NewCoinFromInt64(250000), types.TransferOut)},
 			expectReferrerSaving:    types.NewCoinFromInt64(95 * types.Decimals),
 			expectNewAccountSaving:  types.NewCoinFromInt64(150000),
 			expectNewAccountCoinDay: types.NewCoinFromInt64(1 * types.Decimals),
@@ -355,7 +359,7 @@ func TestHandleUpdateAccountMsg(t *testing.T) {
 		{
 			testName:         "normal update",
 			updateAccountMsg: NewUpdateAccountMsg("accKey", "{'link':'https://lino.network'}"),
-			expectResult:     sdk.Result{},
+			expectResult:     sdk.Result{Tags: types.NewAccountTags("accKey")},
 		},
 		{
 			testName:         "invalid username",
@@ -370,3 +374,100 @@ func TestHandleUpdateAccountMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestHandleVestingTransfer(t *testing.T) {
+	ctx, am, gm := setupTest(t, 1)
+	handler := NewHandler(am, &gm)
+
+	accParam, _ := am.paramHolder.GetAccountParam(ctx)
+	createTestAccount(ctx, am, "user1")
+	createTestAccount(ctx, am, "user2")
+	am.AddSavingCoin(ctx, user1, c2000, "", "", types.TransferIn)
+
+	// user1 transfers 300 LNO to user2, released in 3 pieces every hour.
+	result := handler(ctx, NewVestingTransferMsg("user1", "user2", types.LNO("300"), 3, 3600, memo))
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, user2, types.NewCoinFromInt64(300*types.Decimals), types.VestingTransfer)}, result)
+	startAt := ctx.BlockHeader().Time.Unix()
+
+	senderSaving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, c1600.Plus(c100).Plus(accParam.RegisterFee), senderSaving)
+	receiverSaving, _ := am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, accParam.RegisterFee, receiverSaving)
+	frozenMoneyList, _ := am.GetFrozenMoneyList(ctx, user2)
+	assert.Equal(t, []model.FrozenMoney{
+		{Amount: c300, StartAt: startAt, Times: 3, Interval: 3600, VestingID: 1}}, frozenMoneyList)
+
+	transfer, err := am.GetVestingTransfer(ctx, user1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, model.VestingTransfer{
+		ID: 1, Sender: user1, Receiver: user2, Amount: c300, Released: c0,
+		StartAt: startAt, Interval: 3600, Times: 3, ReleasedTimes: 0, Memo: memo,
+	}, *transfer)
+	for i := int64(1); i <= 3; i++ {
+		eventList := gm.GetTimeEventListAtTime(ctx, startAt+3600*i)
+		assert.Equal(t, []types.Event{
+			VestingReleaseEvent{Sender: user1, Receiver: user2, VestingID: 1, Amount: c100}}, eventList.Events)
+	}
+
+	// release first piece
+	released, err := VestingReleaseEvent{
+		Sender: user1, Receiver: user2, VestingID: 1, Amount: c100}.Execute(ctx, am)
+	assert.Nil(t, err)
+	assert.Equal(t, c100, released)
+	receiverSaving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, c100.Plus(accParam.RegisterFee), receiverSaving)
+	transfer, _ = am.GetVestingTransfer(ctx, user1, 1)
+	assert.Equal(t, c100, transfer.Released)
+	assert.Equal(t, int64(1), transfer.ReleasedTimes)
+	transfers, _ := am.GetVestingTransfers(ctx, user1)
+	assert.Equal(t, []model.VestingTransfer{*transfer}, transfers)
+
+	// only sender can cancel
+	result = handler(ctx, NewCancelVestingTransferMsg("user2", 1))
+	assert.Equal(t, model.ErrVestingTransferNotFound().Result(), result)
+
+	// cancel returns unreleased coin to sender
+	result = handler(ctx, NewCancelVestingTransferMsg("user1", 1))
+	assert.Equal(t, sdk.Result{Tags: types.NewAccountTags(user1)}, result)
+	senderSaving, _ = am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, c1800.Plus(accParam.RegisterFee), senderSaving)
+	frozenMoneyList, _ = am.GetFrozenMoneyList(ctx, user2)
+	assert.Equal(t, 0, len(frozenMoneyList))
+	_, err = am.GetVestingTransfer(ctx, user1, 1)
+	assert.Equal(t, model.ErrVestingTransferNotFound(), err)
+
+	// pending release of cancelled transfer releases nothing
+	released, err = VestingReleaseEvent{
+		Sender: user1, Receiver: user2, VestingID: 1, Amount: c100}.Execute(ctx, am)
+	assert.Nil(t, err)
+	assert.Equal(t, c0, released)
+	receiverSaving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, c100.Plus(accParam.RegisterFee), receiverSaving)
+
+	result = handler(ctx, NewCancelVestingTransferMsg("user1", 1))
+	assert.Equal(t, model.ErrVestingTransferNotFound().Result(), result)
+
+	// vesting transfers in receiver frozen money list are bounded
+	for i := int64(0); i < accParam.MaxNumFrozenMoney; i++ {
+		result = handler(ctx, NewVestingTransferMsg("user1", "user2", types.LNO("100"), 1, 3600, memo))
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			user1, user2, c100, types.VestingTransfer)}, result)
+	}
+	result = handler(ctx, NewVestingTransferMsg("user1", "user2", types.LNO("100"), 1, 3600, memo))
+	assert.Equal(t, ErrFrozenMoneyListTooLong().Result(), result)
+
+	// vesting transfers don't take the room of receiver's own frozen money
+	assert.Nil(t, am.AddFrozenMoney(ctx, user2, c100, startAt, 3600, 3))
+	frozenMoneyList, _ = am.GetFrozenMoneyList(ctx, user2)
+	assert.Equal(t, accParam.MaxNumFrozenMoney+1, int64(len(frozenMoneyList)))
+
+	// cancel removes frozen money of the cancelled transfer only
+	result = handler(ctx, NewCancelVestingTransferMsg("user1", 3))
+	assert.Equal(t, sdk.Result{Tags: types.NewAccountTags(user1)}, result)
+	frozenMoneyList, _ = am.GetFrozenMoneyList(ctx, user2)
+	assert.Equal(t, accParam.MaxNumFrozenMoney, int64(len(frozenMoneyList)))
+	for _, frozenMoney := range frozenMoneyList {
+		assert.NotEqual(t, int64(3), frozenMoney.VestingID)
+	}
+}
@@ -155,7 +155,15 @@ func (accManager AccountManager) AddSavingCoin(
 	if err := accManager.storage.SetBankFromAccountKey(ctx, username, bank); err != nil {
 		return err
 	}
-	return nil
+	return accManager.addBalanceHistory(ctx, username, model.BalanceHistoryDetail{
+		DetailType: detailType,
+		From:       from,
+		To:         username,
+		Amount:     coin,
+		Balance:    bank.Saving,
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+		Memo:       memo,
+	})
 }
 
 // AddSavingCoinWithFullCoinDay - add coin to balance with full coin day
@@ -178,7 +186,15 @@ func (accManager AccountManager) AddSavingCoinWithFullCoinDay(
 	if err := accManager.storage.SetBankFromAccountKey(ctx, username, bank); err != nil {
 		return err
 	}
-	return nil
+	return accManager.addBalanceHistory(ctx, username, model.BalanceHistoryDetail{
+		DetailType: detailType,
+		From:       from,
+		To:         username,
+		Amount:     coin,
+		Balance:    bank.Saving,
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+		Memo:       memo,
+	})
 }
 
 // MinusSavingCoin - minus coin from balance, remove coin day in the tail
@@ -202,6 +218,14 @@ func (accManager AccountManager) MinusSavingCoin(
 	if coin.IsZero() {
 		return nil
 	}
+	detail := model.BalanceHistoryDetail{
+		DetailType: detailType,
+		From:       username,
+		To:         to,
+		Amount:     coin,
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+		Memo:       memo,
+	}
 	accountBank.Saving = accountBank.Saving.Minus(coin)
 	pendingCoinDayQueue, err :=
 		accManager.storage.GetPendingCoinDayQueue(ctx, username)
@@ -255,7 +279,8 @@ func (accManager AccountManager) MinusSavingCoin(
 		ctx, username, accountBank); err != nil {
 		return err
 	}
-	return nil
+	detail.Balance = accountBank.Saving
+	return accManager.addBalanceHistory(ctx, username, detail)
 }
 
 // MinusSavingCoin - minus coin from balance, remove most charged coin day coin
@@ -280,6 +305,15 @@ func (accManager AccountManager) MinusSavingCoinWithFullCoinDay(
 		return types.NewCoinFromInt64(0), ErrAccountSavingCoinNotEnough()
 	}
 	accountBank.Saving = remain
+	detail := model.BalanceHistoryDetail{
+		DetailType: detailType,
+		From:       username,
+		To:         to,
+		Amount:     coin,
+		Balance:    accountBank.Saving,
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+		Memo:       memo,
+	}
 
 	pendingCoinDayQueue, err :=
 		accManager.storage.GetPendingCoinDayQueue(ctx, username)
@@ -336,6 +370,9 @@ func (accManager AccountManager) MinusSavingCoinWithFullCoinDay(
 		ctx, username, accountBank); err != nil {
 		return types.NewCoinFromInt64(0), err
 	}
+	if err := accManager.addBalanceHistory(ctx, username, detail); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
 	return coinDayLost, nil
 }
 
@@ -453,6 +490,35 @@ func (accManager AccountManager) GetFrozenMoneyList(
 	return accountBank.FrozenMoneyList, nil
 }
 
+// GetBalanceHistory - get at most @p limit balance history details of a user recorded
+// before sequence @p before, newest first. before <= 0 starts from the latest detail.
+func (accManager AccountManager) GetBalanceHistory(
+	ctx sdk.Context, username types.AccountKey, before, limit int64) (*model.BalanceHistoryPage, sdk.Error) {
+	if !accManager.DoesAccountExist(ctx, username) {
+		return nil, ErrAccountNotFound(username)
+	}
+	meta, err := accManager.storage.GetBalanceHistoryMeta(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if before <= 0 || before > meta.Total {
+		before = meta.Total
+	}
+	page := &model.BalanceHistoryPage{Details: []model.BalanceHistoryDetail{}}
+	seq := before - 1
+	for ; seq >= meta.Oldest && int64(len(page.Details)) < limit; seq-- {
+		detail, err := accManager.storage.GetBalanceHistoryDetail(ctx, username, seq)
+		if err != nil {
+			return nil, err
+		}
+		page.Details = append(page.Details, *detail)
+	}
+	if seq >= meta.Oldest {
+		page.Next = seq + 1
+	}
+	return page, nil
+}
+
 // IncreaseSequenceByOne - increase user sequence number by one
 func (accManager AccountManager) IncreaseSequenceByOne(
 	ctx sdk.Context, username types.AccountKey) sdk.Error {
@@ -757,6 +823,31 @@ func (accManager AccountManager) addPendingCoinDayToQueue(
 	return accManager.storage.SetPendingCoinDayQueue(ctx, username, pendingCoinDayQueue)
 }
 
+// addBalanceHistory - record a change of saving, only the latest
+// MaxNumBalanceHistory details of each user are kept.
+func (accManager AccountManager) addBalanceHistory(
+	ctx sdk.Context, username types.AccountKey, detail model.BalanceHistoryDetail) sdk.Error {
+	accParams, err := accManager.paramHolder.GetAccountParam(ctx)
+	if err != nil {
+		return err
+	}
+	meta, err := accManager.storage.GetBalanceHistoryMeta(ctx, username)
+	if err != nil {
+		return err
+	}
+	if accParams.MaxNumBalanceHistory > 0 {
+		if err := accManager.storage.SetBalanceHistoryDetail(ctx, username, meta.Total, &detail); err != nil {
+			return err
+		}
+	}
+	meta.Total++
+	for meta.Total-meta.Oldest > max(accParams.MaxNumBalanceHistory, 0) {
+		accManager.storage.DeleteBalanceHistoryDetail(ctx, username, meta.Oldest)
+		meta.Oldest++
+	}
+	return accManager.storage.SetBalanceHistoryMeta(ctx, username, meta)
+}
+
 // RecoverAccount - reset three public key pairs
 func (accManager AccountManager) RecoverAccount(
 	ctx sdk.Context, username types.AccountKey,
@@ -831,24 +922,38 @@ func (accManager AccountManager) updateTXFromPendingCoinDayQueue(
 func (accManager AccountManager) AddFrozenMoney(
 	ctx sdk.Context, username types.AccountKey,
 	amount types.Coin, start, interval, times int64) sdk.Error {
-	accountBank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
-	if err != nil {
-		return err
-	}
-	accManager.cleanExpiredFrozenMoney(ctx, accountBank)
-	frozenMoney := model.FrozenMoney{
+	return accManager.addFrozenMoney(ctx, username, model.FrozenMoney{
 		Amount:   amount,
 		StartAt:  start,
 		Interval: interval,
 		Times:    times,
+	})
+}
+
+// addFrozenMoney - frozen money of vesting transfers and other frozen money are
+// bounded by MaxNumFrozenMoney separately, vesting transfers from others can't
+// take the room of user's own frozen money.
+func (accManager AccountManager) addFrozenMoney(
+	ctx sdk.Context, username types.AccountKey, frozenMoney model.FrozenMoney) sdk.Error {
+	accountBank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
+	if err != nil {
+		return err
 	}
+	accManager.cleanExpiredFrozenMoney(ctx, accountBank)
 
 	accParams, err := accManager.paramHolder.GetAccountParam(ctx)
 	if err != nil {
 		return err
 	}
 
-	if int64(len(accountBank.FrozenMoneyList)) >= accParams.MaxNumFrozenMoney {
+	isVesting := frozenMoney.VestingID != 0
+	numFrozenMoney := int64(0)
+	for _, money := range accountBank.FrozenMoneyList {
+		if (money.VestingID != 0) == isVesting {
+			numFrozenMoney++
+		}
+	}
+	if numFrozenMoney >= accParams.MaxNumFrozenMoney {
 		return ErrFrozenMoneyListTooLong()
 	}
 
@@ -872,25 +977,153 @@ func (accManager AccountManager) cleanExpiredFrozenMoney(ctx sdk.Context, bank *
 	}
 }
 
+// AddVestingTransfer - withdraw coin from sender and record it as frozen money of receiver,
+// the coin is released to receiver in times pieces, one piece every interval seconds.
+func (accManager AccountManager) AddVestingTransfer(
+	ctx sdk.Context, sender, receiver types.AccountKey, amount types.Coin,
+	interval, times int64, memo string) (*model.VestingTransfer, sdk.Error) {
+	id, err := accManager.storage.GetNextVestingID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := accManager.MinusSavingCoin(
+		ctx, sender, amount, receiver, memo, types.VestingTransfer); err != nil {
+		return nil, err
+	}
+	startAt := ctx.BlockHeader().Time.Unix()
+	if err := accManager.addFrozenMoney(ctx, receiver, model.FrozenMoney{
+		Amount:    amount,
+		StartAt:   startAt,
+		Interval:  interval,
+		Times:     times,
+		VestingID: id,
+	}); err != nil {
+		return nil, err
+	}
+	transfer := &model.VestingTransfer{
+		ID:            id,
+		Sender:        sender,
+		Receiver:      receiver,
+		Amount:        amount,
+		Released:      types.NewCoinFromInt64(0),
+		StartAt:       startAt,
+		Interval:      interval,
+		Times:         times,
+		ReleasedTimes: 0,
+		Memo:          memo,
+	}
+	if err := accManager.storage.SetVestingTransfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+	if err := accManager.storage.SetNextVestingID(ctx, id+1); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// ReleaseVestingTransfer - release a piece of vesting transfer to receiver and return
+// the released coin, nothing is released if the transfer has been cancelled.
+func (accManager AccountManager) ReleaseVestingTransfer(
+	ctx sdk.Context, sender types.AccountKey, id int64, piece types.Coin) (types.Coin, sdk.Error) {
+	transfer, err := accManager.storage.GetVestingTransfer(ctx, sender, id)
+	if err != nil {
+		// cancelled transfer has been returned to sender.
+		return types.NewCoinFromInt64(0), nil
+	}
+	if err := accManager.AddSavingCoin(
+		ctx, transfer.Receiver, piece, transfer.Sender, transfer.Memo, types.VestingTransferIn); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	transfer.Released = transfer.Released.Plus(piece)
+	transfer.ReleasedTimes++
+	if transfer.ReleasedTimes >= transfer.Times {
+		accManager.storage.DeleteVestingTransfer(ctx, sender, id)
+		return piece, nil
+	}
+	if err := accManager.storage.SetVestingTransfer(ctx, transfer); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return piece, nil
+}
+
+// CancelVestingTransfer - return unreleased coin of a vesting transfer to sender and
+// remove it from receiver's frozen money list.
+func (accManager AccountManager) CancelVestingTransfer(
+	ctx sdk.Context, sender types.AccountKey, id int64) sdk.Error {
+	transfer, err := accManager.storage.GetVestingTransfer(ctx, sender, id)
+	if err != nil {
+		return err
+	}
+	bank, err := accManager.storage.GetBankFromAccountKey(ctx, transfer.Receiver)
+	if err != nil {
+		return err
+	}
+	for i, frozenMoney := range bank.FrozenMoneyList {
+		if frozenMoney.VestingID == transfer.ID {
+			bank.FrozenMoneyList = append(bank.FrozenMoneyList[:i], bank.FrozenMoneyList[i+1:]...)
+			break
+		}
+	}
+	if err := accManager.storage.SetBankFromAccountKey(ctx, transfer.Receiver, bank); err != nil {
+		return err
+	}
+	accManager.storage.DeleteVestingTransfer(ctx, sender, id)
+	return accManager.AddSavingCoin(
+		ctx, sender, transfer.Amount.Minus(transfer.Released), transfer.Receiver, transfer.Memo,
+		types.VestingTransferBack)
+}
+
+// GetVestingTransfer - get ongoing vesting transfer of sender by id
+func (accManager AccountManager) GetVestingTransfer(
+	ctx sdk.Context, sender types.AccountKey, id int64) (*model.VestingTransfer, sdk.Error) {
+	return accManager.storage.GetVestingTransfer(ctx, sender, id)
+}
+
+// GetVestingTransfers - get all ongoing vesting transfers of sender
+func (accManager AccountManager) GetVestingTransfers(
+	ctx sdk.Context, sender types.AccountKey) ([]model.VestingTransfer, sdk.Error) {
+	return accManager.storage.GetVestingTransfers(ctx, sender)
+}
+
 // Export -
 func (accManager AccountManager) Export(ctx sdk.Context) *model.AccountTables {
 	return accManager.storage.Export(ctx)
 }
 
+// ExportRows - stream rows of account storage through @p write.
+func (accManager AccountManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	accManager.storage.ExportRows(ctx, write)
+}
+
 // Import -
 func (accManager AccountManager) Import(ctx sdk.Context, dt *model.AccountTablesIR) {
 	accManager.storage.Import(ctx, dt)
-	// XXX(yumin): during upgrade-1, we changed the kv of grantPubKey, so we import them here
-	// by calling AuthorizePermission.
 	for _, v := range dt.AccountGrantPubKeys {
-		grant := v.GrantPubKey
-		remainingTime := grant.ExpiresAt - ctx.BlockHeader().Time.Unix()
-		if remainingTime > 0 {
-			// fmt.Printf("%s %s %d %d %d", v.Username, grant.Username,
-			// 	remainingTime, grant.Permission, grant.Amount)
-			accManager.AuthorizePermission(ctx, v.Username, grant.Username,
-				remainingTime, grant.Permission, grant.Amount)
-		}
+		accManager.importGrantPubKey(ctx, v)
+	}
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (accManager AccountManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	if table != model.AccountGrantPubKeyTable {
+		accManager.storage.ImportRow(ctx, table, read)
+		return
+	}
+	row := model.GrantPubKeyRowIR{}
+	if err := read(&row); err != nil {
+		panic("[am] Failed to import: " + err.Error())
+	}
+	accManager.importGrantPubKey(ctx, row)
+}
+
+// XXX(yumin): during upgrade-1, we changed the kv of grantPubKey, so we import them here
+// by calling AuthorizePermission.
+func (accManager AccountManager) importGrantPubKey(ctx sdk.Context, v model.GrantPubKeyRowIR) {
+	grant := v.GrantPubKey
+	remainingTime := grant.ExpiresAt - ctx.BlockHeader().Time.Unix()
+	if remainingTime > 0 {
+		accManager.AuthorizePermission(ctx, v.Username, grant.Username,
+			remainingTime, grant.Permission, grant.Amount)
 	}
 }
 
@@ -2042,3 +2042,112 @@ func TestAddFrozenMoney(t *testing.T) {
 		}
 	}
 }
+
+func TestBalanceHistory(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(user2))
+	accParam, err := am.paramHolder.GetAccountParam(ctx)
+	assert.Nil(t, err)
+	createdAt := ctx.BlockHeader().Time.Unix()
+
+	assert.Nil(t, am.AddSavingCoin(ctx, user1, c200, user2, "transfer", types.TransferIn))
+	assert.Nil(t, am.MinusSavingCoin(ctx, user1, c100, user2, "donate", types.DonationOut))
+	assert.Nil(t, am.AddSavingCoinWithFullCoinDay(ctx, user1, c100, "", "", types.ClaimInterest))
+
+	details := []model.BalanceHistoryDetail{
+		{
+			DetailType: types.ClaimInterest,
+			To:         user1,
+			Amount:     c100,
+			Balance:    accParam.RegisterFee.Plus(c200),
+			CreatedAt:  createdAt,
+		},
+		{
+			DetailType: types.DonationOut,
+			From:       user1,
+			To:         user2,
+			Amount:     c100,
+			Balance:    accParam.RegisterFee.Plus(c100),
+			CreatedAt:  createdAt,
+			Memo:       "donate",
+		},
+		{
+			DetailType: types.TransferIn,
+			From:       user2,
+			To:         user1,
+			Amount:     c200,
+			Balance:    accParam.RegisterFee.Plus(c200),
+			CreatedAt:  createdAt,
+			Memo:       "transfer",
+		},
+		{
+			DetailType: types.TransferIn,
+			From:       accountReferrer,
+			To:         user1,
+			Amount:     accParam.RegisterFee,
+			Balance:    accParam.RegisterFee,
+			CreatedAt:  createdAt,
+			Memo:       types.InitAccountWithFullCoinDayMemo,
+		},
+	}
+
+	testCases := []struct {
+		testName   string
+		before     int64
+		limit      int64
+		expectPage model.BalanceHistoryPage
+	}{
+		{
+			testName:   "first page",
+			before:     0,
+			limit:      2,
+			expectPage: model.BalanceHistoryPage{Details: details[:2], Next: 2},
+		},
+		{
+			testName:   "last page",
+			before:     2,
+			limit:      2,
+			expectPage: model.BalanceHistoryPage{Details: details[2:], Next: 0},
+		},
+		{
+			testName:   "page larger than history",
+			before:     0,
+			limit:      10,
+			expectPage: model.BalanceHistoryPage{Details: details, Next: 0},
+		},
+		{
+			testName:   "before larger than history",
+			before:     100,
+			limit:      1,
+			expectPage: model.BalanceHistoryPage{Details: details[:1], Next: 3},
+		},
+	}
+	for _, tc := range testCases {
+		page, err := am.GetBalanceHistory(ctx, user1, tc.before, tc.limit)
+		if err != nil {
+			t.Errorf("%s: failed to get balance history, got err %v", tc.testName, err)
+			continue
+		}
+		if !assert.Equal(t, tc.expectPage, *page) {
+			t.Errorf("%s: diff balance history page, got %v, want %v", tc.testName, *page, tc.expectPage)
+		}
+	}
+
+	// only the latest MaxNumBalanceHistory details are kept.
+	for i := int64(0); i < accParam.MaxNumBalanceHistory; i++ {
+		assert.Nil(t, am.AddSavingCoin(ctx, user1, coin1, user2, "", types.TransferIn))
+	}
+	meta, err := am.storage.GetBalanceHistoryMeta(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, model.BalanceHistoryMeta{
+		Total: accParam.MaxNumBalanceHistory + 4, Oldest: 4}, *meta)
+	_, err = am.storage.GetBalanceHistoryDetail(ctx, user1, 3)
+	assert.Equal(t, model.ErrBalanceHistoryNotFound(), err)
+	page, err := am.GetBalanceHistory(ctx, user1, 5, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page.Details))
+	assert.Equal(t, int64(0), page.Next)
+}
@@ -26,11 +26,13 @@ type AccountBank struct {
 }
 
 // FrozenMoney - frozen money
+// VestingID - id of the vesting transfer, 0 if the money is not from a vesting transfer
 type FrozenMoney struct {
-	Amount   types.Coin `json:"amount"`
-	StartAt  int64      `json:"start_at"`
-	Times    int64      `json:"times"`
-	Interval int64      `json:"interval"`
+	Amount    types.Coin `json:"amount"`
+	StartAt   int64      `json:"start_at"`
+	Times     int64      `json:"times"`
+	Interval  int64      `json:"interval"`
+	VestingID int64      `json:"vesting_id,omitempty"`
 }
 
 // PendingCoinDayQueue - stores a list of pending coin day and total number of coin waiting in list
@@ -88,6 +90,48 @@ type AccountMeta struct {
 	LastPostAt           int64      `json:"last_post_at"`
 }
 
+// BalanceHistoryDetail - a change of saving, recorded by AddSavingCoin and MinusSavingCoin
+type BalanceHistoryDetail struct {
+	DetailType types.TransferDetailType `json:"detail_type"`
+	From       types.AccountKey         `json:"from"`
+	To         types.AccountKey         `json:"to"`
+	Amount     types.Coin               `json:"amount"`
+	Balance    types.Coin               `json:"balance"`
+	CreatedAt  int64                    `json:"created_at"`
+	Memo       string                   `json:"memo"`
+}
+
+// BalanceHistoryMeta - range of balance history details kept for an account
+// Total - number of details ever recorded, also the sequence of next detail
+// Oldest - sequence of the oldest detail still kept
+type BalanceHistoryMeta struct {
+	Total  int64 `json:"total"`
+	Oldest int64 `json:"oldest"`
+}
+
+// BalanceHistoryPage - a page of balance history details, newest first
+// Next - sequence to query next page before, 0 if there is no more detail
+type BalanceHistoryPage struct {
+	Details []BalanceHistoryDetail `json:"details"`
+	Next    int64                  `json:"next"`
+}
+
+// VestingTransfer - coin sent by sender and released to receiver in Times pieces,
+// one piece every Interval seconds since StartAt. Sender can cancel the transfer
+// and take back the unreleased coin before it is fully released.
+type VestingTransfer struct {
+	ID            int64            `json:"id"`
+	Sender        types.AccountKey `json:"sender"`
+	Receiver      types.AccountKey `json:"receiver"`
+	Amount        types.Coin       `json:"amount"`
+	Released      types.Coin       `json:"released"`
+	StartAt       int64            `json:"start_at"`
+	Interval      int64            `json:"interval"`
+	Times         int64            `json:"times"`
+	ReleasedTimes int64            `json:"released_times"`
+	Memo          string           `json:"memo"`
+}
+
 // AccountInfraConsumption records infra utility consumption
 // type AccountInfraConsumption struct {
 // 	Storage   int64 `json:"storage"`
@@ -38,6 +38,16 @@ func ErrGrantPubKeyNotFound() sdk.Error {
 	return types.NewError(types.CodeGrantPubKeyNotFound, fmt.Sprintf("grant public key is not found"))
 }
 
+// ErrBalanceHistoryNotFound - error if balance history detail is not found
+func ErrBalanceHistoryNotFound() sdk.Error {
+	return types.NewError(types.CodeBalanceHistoryNotFound, fmt.Sprintf("balance history is not found"))
+}
+
+// ErrVestingTransferNotFound - error if vesting transfer is not found
+func ErrVestingTransferNotFound() sdk.Error {
+	return types.NewError(types.CodeVestingTransferNotFound, fmt.Sprintf("vesting transfer is not found"))
+}
+
 // ErrFailedToMarshalAccountInfo - error if marshal account info failed
 func ErrFailedToMarshalAccountInfo(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalAccountInfo, fmt.Sprintf("failed to marshal account info: %s", err.Error()))
@@ -68,6 +78,11 @@ func ErrFailedToMarshalGrantPubKey(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalGrantPubKey, fmt.Sprintf("failed to marshal grant pub key: %s", err.Error()))
 }
 
+// ErrFailedToMarshalBalanceHistory - error if marshal balance history failed
+func ErrFailedToMarshalBalanceHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalBalanceHistory, fmt.Sprintf("failed to marshal balance history: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalAccountInfo - error if unmarshal account info failed
 func ErrFailedToUnmarshalAccountInfo(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalAccountInfo, fmt.Sprintf("failed to unmarshal account info: %s", err.Error()))
@@ -93,7 +108,22 @@ func ErrFailedToUnmarshalPendingCoinDayQueue(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPendingCoinDayQueue, fmt.Sprintf("failed to unmarshal pending coin day queue: %s", err.Error()))
 }
 
+// ErrFailedToMarshalVestingTransfer - error if marshal vesting transfer failed
+func ErrFailedToMarshalVestingTransfer(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalVestingTransfer, fmt.Sprintf("failed to marshal vesting transfer: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalGrantPubKey - error if unmarshal grant public key failed
 func ErrFailedToUnmarshalGrantPubKey(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalGrantPubKey, fmt.Sprintf("failed to unmarshal grant pub key: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalBalanceHistory - error if unmarshal balance history failed
+func ErrFailedToUnmarshalBalanceHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalBalanceHistory, fmt.Sprintf("failed to unmarshal balance history: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalVestingTransfer - error if unmarshal vesting transfer failed
+func ErrFailedToUnmarshalVestingTransfer(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalVestingTransfer, fmt.Sprintf("failed to unmarshal vesting transfer: %s", err.Error()))
+}
@@ -54,6 +54,9 @@ type GrantPubKeyRowIR struct {
 
 // AccountTablesIR -
 type AccountTablesIR struct {
-	Accounts            []AccountRowIR     `json:"accounts"`
-	AccountGrantPubKeys []GrantPubKeyRowIR `json:"account_grant_pub_keys"`
+	Accounts                []AccountRowIR       `json:"accounts"`
+	AccountGrantPubKeys     []GrantPubKeyRowIR   `json:"account_grant_pub_keys"`
+	AccountBalanceHistories []BalanceHistoryRow  `json:"account_balance_histories"`
+	AccountVestingTransfers []VestingTransferRow `json:"account_vesting_transfers"`
+	NextVestingID           NextVestingIDRow     `json:"next_vesting_id"`
 }
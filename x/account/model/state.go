@@ -6,6 +6,15 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// table names of account storage, used in streaming export and import.
+const (
+	AccountTable                = "accounts"
+	AccountGrantPubKeyTable     = "account_grant_pub_keys"
+	AccountBalanceHistoryTable  = "account_balance_histories"
+	AccountVestingTransferTable = "account_vesting_transfers"
+	AccountNextVestingIDTable   = "account_next_vesting_id"
+)
+
 // AccountRow account related information when migrate, pk: Username
 type AccountRow struct {
 	Username            types.AccountKey    `json:"username"`
@@ -44,10 +53,32 @@ func (g GrantPubKeyRow) ToIR() GrantPubKeyRowIR {
 	}
 }
 
+// BalanceHistoryRow - a balance history detail, pk: (Username, Seq)
+type BalanceHistoryRow struct {
+	Username types.AccountKey     `json:"username"`
+	Seq      int64                `json:"seq"`
+	Detail   BalanceHistoryDetail `json:"detail"`
+}
+
+// VestingTransferRow - an ongoing vesting transfer, pk: (Sender, ID)
+type VestingTransferRow struct {
+	Sender   types.AccountKey `json:"sender"`
+	ID       int64            `json:"id"`
+	Transfer VestingTransfer  `json:"transfer"`
+}
+
+// NextVestingIDRow - id of next vesting transfer.
+type NextVestingIDRow struct {
+	NextVestingID int64 `json:"next_vesting_id"`
+}
+
 // AccountTables is the state of account storage, organized as a table.
 type AccountTables struct {
-	Accounts            []AccountRow     `json:"accounts"`
-	AccountGrantPubKeys []GrantPubKeyRow `json:"account_grant_pub_keys"`
+	Accounts                []AccountRow         `json:"accounts"`
+	AccountGrantPubKeys     []GrantPubKeyRow     `json:"account_grant_pub_keys"`
+	AccountBalanceHistories []BalanceHistoryRow  `json:"account_balance_histories"`
+	AccountVestingTransfers []VestingTransferRow `json:"account_vesting_transfers"`
+	NextVestingID           NextVestingIDRow     `json:"next_vesting_id"`
 }
 
 // ToIR -
@@ -59,5 +90,8 @@ func (a AccountTables) ToIR() *AccountTablesIR {
 	for _, v := range a.AccountGrantPubKeys {
 		tables.AccountGrantPubKeys = append(tables.AccountGrantPubKeys, v.ToIR())
 	}
+	tables.AccountBalanceHistories = a.AccountBalanceHistories
+	tables.AccountVestingTransfers = a.AccountVestingTransfers
+	tables.NextVestingID = a.NextVestingID
 	return tables
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/binary"
 	"strings"
 
 	"github.com/lino-network/lino/types"
@@ -16,6 +17,11 @@ var (
 	accountRewardSubstore              = []byte{0x03}
 	accountPendingCoinDayQueueSubstore = []byte{0x04}
 	accountGrantPubKeySubstore         = []byte{0x05}
+	// balance history is no longer bucketed, deprecated 0x08 is not reused.
+	accountBalanceHistorySubstore     = []byte{0x0b}
+	accountBalanceHistoryMetaSubstore = []byte{0x0c}
+	accountVestingTransferSubstore    = []byte{0x0d}
+	accountNextVestingIDSubstore      = []byte{0x0e}
 	// XXX(yukai): deprecated.
 	// accountFollowerSubstore            = []byte{0x03}
 	// accountFollowingSubstore           = []byte{0x04}
@@ -231,6 +237,141 @@ func (as AccountStorage) SetGrantPermissions(ctx sdk.Context, me types.AccountKe
 	return nil
 }
 
+// GetBalanceHistoryMeta - returns range of balance history kept for a given account,
+// an account without balance history has an empty meta.
+func (as AccountStorage) GetBalanceHistoryMeta(
+	ctx sdk.Context, me types.AccountKey) (*BalanceHistoryMeta, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	metaByte := store.Get(getBalanceHistoryMetaKey(me))
+	meta := new(BalanceHistoryMeta)
+	if metaByte == nil {
+		return meta, nil
+	}
+	if err := as.cdc.UnmarshalBinaryLengthPrefixed(metaByte, meta); err != nil {
+		return nil, ErrFailedToUnmarshalBalanceHistory(err)
+	}
+	return meta, nil
+}
+
+// SetBalanceHistoryMeta - sets range of balance history kept for a given account.
+func (as AccountStorage) SetBalanceHistoryMeta(
+	ctx sdk.Context, me types.AccountKey, meta *BalanceHistoryMeta) sdk.Error {
+	store := ctx.KVStore(as.key)
+	metaByte, err := as.cdc.MarshalBinaryLengthPrefixed(*meta)
+	if err != nil {
+		return ErrFailedToMarshalBalanceHistory(err)
+	}
+	store.Set(getBalanceHistoryMetaKey(me), metaByte)
+	return nil
+}
+
+// GetBalanceHistoryDetail - returns balance history detail of a given account at sequence.
+func (as AccountStorage) GetBalanceHistoryDetail(
+	ctx sdk.Context, me types.AccountKey, seq int64) (*BalanceHistoryDetail, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	detailByte := store.Get(getBalanceHistoryKey(me, seq))
+	if detailByte == nil {
+		return nil, ErrBalanceHistoryNotFound()
+	}
+	detail := new(BalanceHistoryDetail)
+	if err := as.cdc.UnmarshalBinaryLengthPrefixed(detailByte, detail); err != nil {
+		return nil, ErrFailedToUnmarshalBalanceHistory(err)
+	}
+	return detail, nil
+}
+
+// SetBalanceHistoryDetail - sets balance history detail of a given account at sequence.
+func (as AccountStorage) SetBalanceHistoryDetail(
+	ctx sdk.Context, me types.AccountKey, seq int64, detail *BalanceHistoryDetail) sdk.Error {
+	store := ctx.KVStore(as.key)
+	detailByte, err := as.cdc.MarshalBinaryLengthPrefixed(*detail)
+	if err != nil {
+		return ErrFailedToMarshalBalanceHistory(err)
+	}
+	store.Set(getBalanceHistoryKey(me, seq), detailByte)
+	return nil
+}
+
+// DeleteBalanceHistoryDetail - deletes balance history detail of a given account at sequence.
+func (as AccountStorage) DeleteBalanceHistoryDetail(ctx sdk.Context, me types.AccountKey, seq int64) {
+	store := ctx.KVStore(as.key)
+	store.Delete(getBalanceHistoryKey(me, seq))
+}
+
+// GetVestingTransfer - returns vesting transfer of sender by id.
+func (as AccountStorage) GetVestingTransfer(
+	ctx sdk.Context, sender types.AccountKey, id int64) (*VestingTransfer, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	transferByte := store.Get(getVestingTransferKey(sender, id))
+	if transferByte == nil {
+		return nil, ErrVestingTransferNotFound()
+	}
+	transfer := new(VestingTransfer)
+	if err := as.cdc.UnmarshalBinaryLengthPrefixed(transferByte, transfer); err != nil {
+		return nil, ErrFailedToUnmarshalVestingTransfer(err)
+	}
+	return transfer, nil
+}
+
+// SetVestingTransfer - sets vesting transfer, keyed by its sender and id.
+func (as AccountStorage) SetVestingTransfer(ctx sdk.Context, transfer *VestingTransfer) sdk.Error {
+	store := ctx.KVStore(as.key)
+	transferByte, err := as.cdc.MarshalBinaryLengthPrefixed(*transfer)
+	if err != nil {
+		return ErrFailedToMarshalVestingTransfer(err)
+	}
+	store.Set(getVestingTransferKey(transfer.Sender, transfer.ID), transferByte)
+	return nil
+}
+
+// DeleteVestingTransfer - deletes vesting transfer of sender by id.
+func (as AccountStorage) DeleteVestingTransfer(ctx sdk.Context, sender types.AccountKey, id int64) {
+	store := ctx.KVStore(as.key)
+	store.Delete(getVestingTransferKey(sender, id))
+}
+
+// GetVestingTransfers - returns all ongoing vesting transfers of sender, in id order.
+func (as AccountStorage) GetVestingTransfers(
+	ctx sdk.Context, sender types.AccountKey) ([]VestingTransfer, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	itr := sdk.KVStorePrefixIterator(store, getVestingTransferPrefix(sender))
+	defer itr.Close()
+	transfers := []VestingTransfer{}
+	for ; itr.Valid(); itr.Next() {
+		transfer := VestingTransfer{}
+		if err := as.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &transfer); err != nil {
+			return nil, ErrFailedToUnmarshalVestingTransfer(err)
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+// GetNextVestingID - returns id of next vesting transfer, starts from 1.
+func (as AccountStorage) GetNextVestingID(ctx sdk.Context) (int64, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	idByte := store.Get(getNextVestingIDKey())
+	if idByte == nil {
+		return 1, nil
+	}
+	id := int64(0)
+	if err := as.cdc.UnmarshalBinaryLengthPrefixed(idByte, &id); err != nil {
+		return 0, ErrFailedToUnmarshalVestingTransfer(err)
+	}
+	return id, nil
+}
+
+// SetNextVestingID - sets id of next vesting transfer.
+func (as AccountStorage) SetNextVestingID(ctx sdk.Context, id int64) sdk.Error {
+	store := ctx.KVStore(as.key)
+	idByte, err := as.cdc.MarshalBinaryLengthPrefixed(id)
+	if err != nil {
+		return ErrFailedToMarshalVestingTransfer(err)
+	}
+	store.Set(getNextVestingIDKey(), idByte)
+	return nil
+}
+
 // GetAccountInfoPrefix - "account info substore"
 func GetAccountInfoPrefix() []byte {
 	return accountInfoSubstore
@@ -267,110 +408,299 @@ func getGrantPermKey(me types.AccountKey, grantTo types.AccountKey) []byte {
 	return append(append(getGrantPermPrefix(me), grantTo...), types.KeySeparator...)
 }
 
+func getBalanceHistoryPrefix(me types.AccountKey) []byte {
+	return append(append(accountBalanceHistorySubstore, me...), types.KeySeparator...)
+}
+
+// getBalanceHistoryKey - "balance history substore" + "username" + "/" + big endian sequence,
+// so that details of an account are iterated in the order they are recorded.
+func getBalanceHistoryKey(me types.AccountKey, seq int64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, uint64(seq))
+	return append(getBalanceHistoryPrefix(me), seqBytes...)
+}
+
+func getBalanceHistoryMetaKey(me types.AccountKey) []byte {
+	return append(accountBalanceHistoryMetaSubstore, me...)
+}
+
+func getVestingTransferPrefix(sender types.AccountKey) []byte {
+	return append(append(accountVestingTransferSubstore, sender...), types.KeySeparator...)
+}
+
+// getVestingTransferKey - "vesting transfer substore" + "sender" + "/" + big endian id
+func getVestingTransferKey(sender types.AccountKey, id int64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, uint64(id))
+	return append(getVestingTransferPrefix(sender), idBytes...)
+}
+
+func getNextVestingIDKey() []byte {
+	return accountNextVestingIDSubstore
+}
+
 // Export to table representation.
 func (as AccountStorage) Export(ctx sdk.Context) *AccountTables {
 	tables := &AccountTables{}
+	as.iterateAccountRows(ctx, func(row AccountRow) {
+		tables.Accounts = append(tables.Accounts, row)
+	})
+	as.iterateGrantPubKeyRows(ctx, func(row GrantPubKeyRow) {
+		tables.AccountGrantPubKeys = append(tables.AccountGrantPubKeys, row)
+	})
+	as.iterateBalanceHistoryRows(ctx, func(row BalanceHistoryRow) {
+		tables.AccountBalanceHistories = append(tables.AccountBalanceHistories, row)
+	})
+	as.iterateVestingTransferRows(ctx, func(row VestingTransferRow) {
+		tables.AccountVestingTransfers = append(tables.AccountVestingTransfers, row)
+	})
+	tables.NextVestingID = as.nextVestingIDRow(ctx)
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (as AccountStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	as.iterateAccountRows(ctx, func(row AccountRow) {
+		write(AccountTable, row.ToIR())
+	})
+	as.iterateGrantPubKeyRows(ctx, func(row GrantPubKeyRow) {
+		write(AccountGrantPubKeyTable, row.ToIR())
+	})
+	as.iterateBalanceHistoryRows(ctx, func(row BalanceHistoryRow) {
+		write(AccountBalanceHistoryTable, row)
+	})
+	as.iterateVestingTransferRows(ctx, func(row VestingTransferRow) {
+		write(AccountVestingTransferTable, row)
+	})
+	write(AccountNextVestingIDTable, as.nextVestingIDRow(ctx))
+}
+
+func (as AccountStorage) iterateAccountRows(ctx sdk.Context, process func(AccountRow)) {
 	store := ctx.KVStore(as.key)
-	// export tables.account
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, accountInfoSubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k, _ := itr.Key(), itr.Value()
-			username := types.AccountKey(k[1:])
-
-			accInfo, err := as.GetInfo(ctx, username)
-			if err != nil {
-				panic(err)
-			}
-
-			accBank, err := as.GetBankFromAccountKey(ctx, username)
-			if err != nil {
-				panic(err)
-			}
-
-			accMeta, err := as.GetMeta(ctx, username)
-			if err != nil {
-				panic(err)
-			}
-
-			accPending, err := as.GetPendingCoinDayQueue(ctx, username)
-			if err != nil {
-				panic(err)
-			}
-
-			reward, err := as.GetReward(ctx, username)
-			if err != nil {
-				panic(err)
-			}
-
-			// set all states
-			accRow := AccountRow{
-				Username:            username,
-				Info:                *accInfo,
-				Bank:                *accBank,
-				Meta:                *accMeta,
-				Reward:              *reward,
-				PendingCoinDayQueue: *accPending,
-			}
-			tables.Accounts = append(tables.Accounts, accRow)
+	itr := sdk.KVStorePrefixIterator(store, accountInfoSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k, _ := itr.Key(), itr.Value()
+		username := types.AccountKey(k[1:])
+
+		accInfo, err := as.GetInfo(ctx, username)
+		if err != nil {
+			panic(err)
 		}
-	}()
-	// export tables.GrantPubKeys
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, accountGrantPubKeySubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			usernameApp := string(itr.Key()[1:])
-			strs := strings.Split(usernameApp, types.KeySeparator)
-			if len(strs) != 3 {
-				panic("illegat usernamePubkeyAndPermission: " + usernameApp)
-			}
-			username, app := types.AccountKey(strs[0]), types.AccountKey(strs[1])
-			permissions, err := as.GetGrantPermissions(ctx, username, app)
-			if err != nil {
-				panic("failed to fetch permission for " + username + " and " + app)
-			}
-			for _, v := range permissions {
-				row := GrantPubKeyRow{
-					Username:    username,
-					PubKey:      nil, // PubKey is deprecated since upgrade1
-					GrantPubKey: *v,
-				}
-				tables.AccountGrantPubKeys = append(tables.AccountGrantPubKeys, row)
-			}
+
+		accBank, err := as.GetBankFromAccountKey(ctx, username)
+		if err != nil {
+			panic(err)
 		}
-	}()
-	return tables
+
+		accMeta, err := as.GetMeta(ctx, username)
+		if err != nil {
+			panic(err)
+		}
+
+		accPending, err := as.GetPendingCoinDayQueue(ctx, username)
+		if err != nil {
+			panic(err)
+		}
+
+		reward, err := as.GetReward(ctx, username)
+		if err != nil {
+			panic(err)
+		}
+
+		// set all states
+		process(AccountRow{
+			Username:            username,
+			Info:                *accInfo,
+			Bank:                *accBank,
+			Meta:                *accMeta,
+			Reward:              *reward,
+			PendingCoinDayQueue: *accPending,
+		})
+	}
+}
+
+func (as AccountStorage) iterateGrantPubKeyRows(ctx sdk.Context, process func(GrantPubKeyRow)) {
+	store := ctx.KVStore(as.key)
+	itr := sdk.KVStorePrefixIterator(store, accountGrantPubKeySubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		usernameApp := string(itr.Key()[1:])
+		strs := strings.Split(usernameApp, types.KeySeparator)
+		if len(strs) != 3 {
+			panic("illegat usernamePubkeyAndPermission: " + usernameApp)
+		}
+		username, app := types.AccountKey(strs[0]), types.AccountKey(strs[1])
+		permissions, err := as.GetGrantPermissions(ctx, username, app)
+		if err != nil {
+			panic("failed to fetch permission for " + username + " and " + app)
+		}
+		for _, v := range permissions {
+			process(GrantPubKeyRow{
+				Username:    username,
+				PubKey:      nil, // PubKey is deprecated since upgrade1
+				GrantPubKey: *v,
+			})
+		}
+	}
+}
+
+func (as AccountStorage) iterateBalanceHistoryRows(ctx sdk.Context, process func(BalanceHistoryRow)) {
+	store := ctx.KVStore(as.key)
+	itr := sdk.KVStorePrefixIterator(store, accountBalanceHistorySubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		// substore + username + separator + 8 bytes sequence
+		if len(k) < 1+len(types.KeySeparator)+8 {
+			panic("illegal balance history key: " + string(k))
+		}
+		username := types.AccountKey(k[1 : len(k)-8-len(types.KeySeparator)])
+		seq := int64(binary.BigEndian.Uint64(k[len(k)-8:]))
+		detail := new(BalanceHistoryDetail)
+		if err := as.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), detail); err != nil {
+			panic("failed to read balance history of " + username + ": " + err.Error())
+		}
+		process(BalanceHistoryRow{
+			Username: username,
+			Seq:      seq,
+			Detail:   *detail,
+		})
+	}
+}
+
+func (as AccountStorage) iterateVestingTransferRows(ctx sdk.Context, process func(VestingTransferRow)) {
+	store := ctx.KVStore(as.key)
+	itr := sdk.KVStorePrefixIterator(store, accountVestingTransferSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		transfer := VestingTransfer{}
+		if err := as.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &transfer); err != nil {
+			panic("failed to read vesting transfer: " + err.Error())
+		}
+		process(VestingTransferRow{
+			Sender:   transfer.Sender,
+			ID:       transfer.ID,
+			Transfer: transfer,
+		})
+	}
+}
+
+func (as AccountStorage) nextVestingIDRow(ctx sdk.Context) NextVestingIDRow {
+	id, err := as.GetNextVestingID(ctx)
+	if err != nil {
+		panic("failed to get next vesting id: " + err.Error())
+	}
+	return NextVestingIDRow{
+		NextVestingID: id,
+	}
 }
 
 // Import from tablesIR.
 func (as AccountStorage) Import(ctx sdk.Context, tb *AccountTablesIR) {
+	// import table.accounts
+	for _, v := range tb.Accounts {
+		as.importAccountRow(ctx, v)
+	}
+	// import table.AccountBalanceHistories
+	for _, v := range tb.AccountBalanceHistories {
+		as.importBalanceHistoryRow(ctx, v)
+	}
+	// import table.AccountVestingTransfers
+	for _, v := range tb.AccountVestingTransfers {
+		if err := as.SetVestingTransfer(ctx, &v.Transfer); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+	}
+	// snapshots taken before vesting transfers have no next vesting id.
+	if tb.NextVestingID.NextVestingID > 0 {
+		if err := as.SetNextVestingID(ctx, tb.NextVestingID.NextVestingID); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+	}
+	// AccountGrantPubKeys are not imported here and should and is done in manager.
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (as AccountStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	switch table {
+	case AccountTable:
+		row := AccountRowIR{}
+		if err := read(&row); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+		as.importAccountRow(ctx, row)
+	case AccountGrantPubKeyTable:
+		// AccountGrantPubKeys are not imported here and should and is done in manager.
+	case AccountBalanceHistoryTable:
+		row := BalanceHistoryRow{}
+		if err := read(&row); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+		as.importBalanceHistoryRow(ctx, row)
+	case AccountVestingTransferTable:
+		row := VestingTransferRow{}
+		if err := read(&row); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+		if err := as.SetVestingTransfer(ctx, &row.Transfer); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+	case AccountNextVestingIDTable:
+		row := NextVestingIDRow{}
+		if err := read(&row); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+		if err := as.SetNextVestingID(ctx, row.NextVestingID); err != nil {
+			panic("[as] Failed to import: " + err.Error())
+		}
+	default:
+		panic("[as] Failed to import: unknown table " + table)
+	}
+}
+
+func (as AccountStorage) importAccountRow(ctx sdk.Context, v AccountRowIR) {
 	check := func(err error) {
 		if err != nil {
 			panic("[as] Failed to import: " + err.Error())
 		}
 	}
-	// import table.accounts
-	for _, v := range tb.Accounts {
-		err := as.SetInfo(ctx, v.Username, &v.Info)
-		check(err)
-		err = as.SetBankFromAccountKey(ctx, v.Username, &v.Bank)
-		check(err)
-		err = as.SetMeta(ctx, v.Username, &v.Meta)
-		check(err)
-		err = as.SetReward(ctx, v.Username, &v.Reward)
-		check(err)
-		q := &PendingCoinDayQueue{
-			LastUpdatedAt:   v.PendingCoinDayQueue.LastUpdatedAt,
-			TotalCoinDay:    sdk.MustNewDecFromStr(v.PendingCoinDayQueue.TotalCoinDay),
-			TotalCoin:       v.PendingCoinDayQueue.TotalCoin,
-			PendingCoinDays: v.PendingCoinDayQueue.PendingCoinDays,
+	err := as.SetInfo(ctx, v.Username, &v.Info)
+	check(err)
+	err = as.SetBankFromAccountKey(ctx, v.Username, &v.Bank)
+	check(err)
+	err = as.SetMeta(ctx, v.Username, &v.Meta)
+	check(err)
+	err = as.SetReward(ctx, v.Username, &v.Reward)
+	check(err)
+	q := &PendingCoinDayQueue{
+		LastUpdatedAt:   v.PendingCoinDayQueue.LastUpdatedAt,
+		TotalCoinDay:    sdk.MustNewDecFromStr(v.PendingCoinDayQueue.TotalCoinDay),
+		TotalCoin:       v.PendingCoinDayQueue.TotalCoin,
+		PendingCoinDays: v.PendingCoinDayQueue.PendingCoinDays,
+	}
+	err = as.SetPendingCoinDayQueue(ctx, v.Username, q)
+	check(err)
+}
+
+// importBalanceHistoryRow - details of an account are exported in sequence order,
+// the kept range is rebuilt from them.
+func (as AccountStorage) importBalanceHistoryRow(ctx sdk.Context, v BalanceHistoryRow) {
+	check := func(err error) {
+		if err != nil {
+			panic("[as] Failed to import: " + err.Error())
 		}
-		err = as.SetPendingCoinDayQueue(ctx, v.Username, q)
-		check(err)
 	}
-	// AccountGrantPubKeys are not imported here and should and is done in manager.
+	meta, err := as.GetBalanceHistoryMeta(ctx, v.Username)
+	check(err)
+	if meta.Total == 0 {
+		meta.Oldest = v.Seq
+	}
+	if v.Seq >= meta.Total {
+		meta.Total = v.Seq + 1
+	}
+	check(as.SetBalanceHistoryDetail(ctx, v.Username, v.Seq, &v.Detail))
+	check(as.SetBalanceHistoryMeta(ctx, v.Username, meta))
 }
 
 // IterateAccounts - iterate accounts in KVStore
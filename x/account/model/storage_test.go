@@ -171,3 +171,115 @@ func TestPendingCoinDayQueue(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, *pendingCoinDayQueue, *resultPtr, "Account pending coin day queue should be equal")
 }
+
+func TestExportImportRows(t *testing.T) {
+	as := NewAccountStorage(TestKVStoreKey)
+	ctx := getContext()
+	user := types.AccountKey("test")
+
+	accInfo := AccountInfo{
+		Username:       user,
+		ResetKey:       secp256k1.GenPrivKey().PubKey(),
+		TransactionKey: secp256k1.GenPrivKey().PubKey(),
+		AppKey:         secp256k1.GenPrivKey().PubKey(),
+	}
+	accBank := AccountBank{
+		Saving:  types.NewCoinFromInt64(123),
+		CoinDay: types.NewCoinFromInt64(12),
+	}
+	accMeta := AccountMeta{TransactionCapacity: types.NewCoinFromInt64(1)}
+	reward := Reward{
+		TotalIncome:     types.NewCoinFromInt64(5),
+		OriginalIncome:  types.NewCoinFromInt64(4),
+		FrictionIncome:  types.NewCoinFromInt64(3),
+		InflationIncome: types.NewCoinFromInt64(2),
+		UnclaimReward:   types.NewCoinFromInt64(1),
+	}
+	pendingCoinDayQueue := PendingCoinDayQueue{
+		TotalCoinDay: sdk.OneDec(),
+		TotalCoin:    types.NewCoinFromInt64(1000),
+		PendingCoinDays: []PendingCoinDay{
+			{StartTime: 1, EndTime: 2, Coin: types.NewCoinFromInt64(1000)}},
+	}
+	assert.Nil(t, as.SetInfo(ctx, user, &accInfo))
+	assert.Nil(t, as.SetBankFromAccountKey(ctx, user, &accBank))
+	assert.Nil(t, as.SetMeta(ctx, user, &accMeta))
+	assert.Nil(t, as.SetReward(ctx, user, &reward))
+	assert.Nil(t, as.SetPendingCoinDayQueue(ctx, user, &pendingCoinDayQueue))
+	detail := BalanceHistoryDetail{
+		DetailType: types.TransferIn,
+		From:       types.AccountKey("from"),
+		To:         user,
+		Amount:     types.NewCoinFromInt64(1),
+		Balance:    types.NewCoinFromInt64(123),
+		CreatedAt:  1,
+		Memo:       "memo",
+	}
+	assert.Nil(t, as.SetBalanceHistoryDetail(ctx, user, 5, &detail))
+	assert.Nil(t, as.SetBalanceHistoryMeta(ctx, user, &BalanceHistoryMeta{Total: 6, Oldest: 5}))
+	vesting := VestingTransfer{
+		ID:            3,
+		Sender:        user,
+		Receiver:      types.AccountKey("receiver"),
+		Amount:        types.NewCoinFromInt64(100),
+		Released:      types.NewCoinFromInt64(50),
+		StartAt:       1,
+		Interval:      10,
+		Times:         2,
+		ReleasedTimes: 1,
+		Memo:          "memo",
+	}
+	assert.Nil(t, as.SetVestingTransfer(ctx, &vesting))
+	assert.Nil(t, as.SetNextVestingID(ctx, 4))
+
+	type row struct {
+		table string
+		bytes []byte
+	}
+	rows := []row{}
+	as.ExportRows(ctx, func(table string, r interface{}) {
+		bz, err := as.cdc.MarshalJSON(r)
+		assert.Nil(t, err)
+		rows = append(rows, row{table: table, bytes: bz})
+	})
+	assert.Equal(t, 4, len(rows))
+	assert.Equal(t, AccountTable, rows[0].table)
+	assert.Equal(t, AccountBalanceHistoryTable, rows[1].table)
+	assert.Equal(t, AccountVestingTransferTable, rows[2].table)
+	assert.Equal(t, AccountNextVestingIDTable, rows[3].table)
+
+	ctx = getContext()
+	for _, r := range rows {
+		bz := r.bytes
+		as.ImportRow(ctx, r.table, func(v interface{}) error {
+			return as.cdc.UnmarshalJSON(bz, v)
+		})
+	}
+	info, err := as.GetInfo(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, accInfo, *info)
+	bank, err := as.GetBankFromAccountKey(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, accBank, *bank)
+	meta, err := as.GetMeta(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, accMeta, *meta)
+	rewardPtr, err := as.GetReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, reward, *rewardPtr)
+	queue, err := as.GetPendingCoinDayQueue(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, pendingCoinDayQueue, *queue)
+	detailPtr, err := as.GetBalanceHistoryDetail(ctx, user, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, detail, *detailPtr)
+	meta, err := as.GetBalanceHistoryMeta(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, BalanceHistoryMeta{Total: 6, Oldest: 5}, *meta)
+	vestingPtr, err := as.GetVestingTransfer(ctx, user, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, vesting, *vestingPtr)
+	nextID, err := as.GetNextVestingID(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), nextID)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/lino-network/lino/types"
 	crypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,6 +17,8 @@ var _ types.Msg = TransferMsg{}
 var _ types.Msg = RecoverMsg{}
 var _ types.Msg = RegisterMsg{}
 var _ types.Msg = UpdateAccountMsg{}
+var _ types.Msg = VestingTransferMsg{}
+var _ types.Msg = CancelVestingTransferMsg{}
 
 // RegisterMsg - bind username with public key, need to be referred by others (pay for it)
 type RegisterMsg struct {
@@ -54,6 +57,22 @@ type UpdateAccountMsg struct {
 	JSONMeta string           `json:"json_meta"`
 }
 
+// VestingTransferMsg - sender transfer money to receiver, released in times pieces every interval seconds
+type VestingTransferMsg struct {
+	Sender      types.AccountKey `json:"sender"`
+	Receiver    types.AccountKey `json:"receiver"`
+	Amount      types.LNO        `json:"amount"`
+	Times       int64            `json:"times"`
+	IntervalSec int64            `json:"interval_sec"`
+	Memo        string           `json:"memo"`
+}
+
+// CancelVestingTransferMsg - sender takes back the unreleased money of a vesting transfer
+type CancelVestingTransferMsg struct {
+	Sender    types.AccountKey `json:"sender"`
+	VestingID int64            `json:"vesting_id"`
+}
+
 // NewClaimMsg - return a ClaimMsg
 func NewClaimMsg(username string) ClaimMsg {
 	return ClaimMsg{
@@ -193,7 +212,7 @@ func (msg RecoverMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername("illegal length")
 	}
 
-	return nil
+	return validatePubKeys(msg.NewResetPubKey, msg.NewTransactionPubKey, msg.NewAppPubKey)
 }
 
 func (msg RecoverMsg) String() string {
@@ -274,7 +293,7 @@ func (msg RegisterMsg) ValidateBasic() sdk.Error {
 	if coinErr != nil {
 		return coinErr
 	}
-	return nil
+	return validatePubKeys(msg.NewResetPubKey, msg.NewTransactionPubKey, msg.NewAppPubKey)
 }
 
 func (msg RegisterMsg) String() string {
@@ -361,3 +380,167 @@ func (msg UpdateAccountMsg) GetSigners() []sdk.AccAddress {
 func (msg UpdateAccountMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewVestingTransferMsg - return a VestingTransferMsg
+func NewVestingTransferMsg(
+	sender, receiver string, amount types.LNO, times, intervalSec int64, memo string) VestingTransferMsg {
+	return VestingTransferMsg{
+		Sender:      types.AccountKey(sender),
+		Receiver:    types.AccountKey(receiver),
+		Amount:      amount,
+		Times:       times,
+		IntervalSec: intervalSec,
+		Memo:        memo,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg VestingTransferMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg VestingTransferMsg) Type() string { return "VestingTransferMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg VestingTransferMsg) ValidateBasic() sdk.Error {
+	if len(msg.Sender) < types.MinimumUsernameLength ||
+		len(msg.Sender) > types.MaximumUsernameLength ||
+		len(msg.Receiver) < types.MinimumUsernameLength ||
+		len(msg.Receiver) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.Sender == msg.Receiver {
+		return ErrVestingTransferToSelf()
+	}
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	if !coin.IsGTE(types.NewCoinFromInt64(types.MinimumVestingTransferAmount)) {
+		return ErrVestingTransferAmountTooLow()
+	}
+	if msg.Times <= 0 || msg.Times > types.MaximumVestingTransferTimes {
+		return ErrInvalidVestingSchedule("illegal times")
+	}
+	if msg.IntervalSec <= 0 {
+		return ErrInvalidVestingSchedule("illegal interval")
+	}
+
+	if len(msg.Memo) > types.MaximumMemoLength {
+		return ErrInvalidMemo()
+	}
+	return nil
+}
+
+func (msg VestingTransferMsg) String() string {
+	return fmt.Sprintf("VestingTransferMsg{Sender:%v, Receiver:%v, Amount:%v, Times:%v, IntervalSec:%v, Memo:%v}",
+		msg.Sender, msg.Receiver, msg.Amount, msg.Times, msg.IntervalSec, msg.Memo)
+}
+
+// GetPermission - implements types.Msg
+func (msg VestingTransferMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg VestingTransferMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg VestingTransferMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Sender)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg VestingTransferMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewCancelVestingTransferMsg - return a CancelVestingTransferMsg
+func NewCancelVestingTransferMsg(sender string, vestingID int64) CancelVestingTransferMsg {
+	return CancelVestingTransferMsg{
+		Sender:    types.AccountKey(sender),
+		VestingID: vestingID,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg CancelVestingTransferMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg CancelVestingTransferMsg) Type() string { return "CancelVestingTransferMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg CancelVestingTransferMsg) ValidateBasic() sdk.Error {
+	if len(msg.Sender) < types.MinimumUsernameLength ||
+		len(msg.Sender) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	if msg.VestingID <= 0 {
+		return ErrInvalidVestingSchedule("illegal vesting id")
+	}
+	return nil
+}
+
+func (msg CancelVestingTransferMsg) String() string {
+	return fmt.Sprintf("CancelVestingTransferMsg{Sender:%v, VestingID:%v}", msg.Sender, msg.VestingID)
+}
+
+// GetPermission - implements types.Msg
+func (msg CancelVestingTransferMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg CancelVestingTransferMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg CancelVestingTransferMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Sender)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg CancelVestingTransferMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// validatePubKeys - a threshold multisig key must have a reachable threshold and
+// at most types.MaximumMultisigPubKeys distinct keys, none of which is a multisig key.
+func validatePubKeys(pubKeys ...crypto.PubKey) sdk.Error {
+	for _, pubKey := range pubKeys {
+		multisigKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+		if !ok {
+			continue
+		}
+		if multisigKey.K == 0 || multisigKey.K > uint(len(multisigKey.PubKeys)) {
+			return ErrInvalidMultisigPubKey("illegal threshold")
+		}
+		if len(multisigKey.PubKeys) > types.MaximumMultisigPubKeys {
+			return ErrInvalidMultisigPubKey("too many keys")
+		}
+		for i, key := range multisigKey.PubKeys {
+			if key == nil {
+				return ErrInvalidMultisigPubKey("missing key")
+			}
+			if _, nested := key.(multisig.PubKeyMultisigThreshold); nested {
+				return ErrInvalidMultisigPubKey("nested multisig key")
+			}
+			for _, other := range multisigKey.PubKeys[:i] {
+				if key.Equals(other) {
+					return ErrInvalidMultisigPubKey("duplicated key")
+				}
+			}
+		}
+	}
+	return nil
+}
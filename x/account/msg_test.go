@@ -6,6 +6,8 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	crypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -85,7 +87,106 @@ func TestTransferMsg(t *testing.T) {
 	}
 }
 
+func TestVestingTransferMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      VestingTransferMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case": {
+			msg:      NewVestingTransferMsg("userA", "userB", types.LNO("1900"), 10, 3600, memo1),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid vesting transfer - no receiver provided": {
+			msg:      NewVestingTransferMsg("userA", "", types.LNO("1900"), 10, 3600, memo1),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid vesting transfer - transfer to self": {
+			msg:      NewVestingTransferMsg("userA", "userA", types.LNO("1900"), 10, 3600, memo1),
+			wantCode: types.CodeVestingTransferToSelf,
+		},
+		"invalid vesting transfer - amount is too low": {
+			msg:      NewVestingTransferMsg("userA", "userB", types.LNO("99.99999"), 10, 3600, memo1),
+			wantCode: types.CodeVestingTransferAmountTooLow,
+		},
+		"invalid vesting transfer - amount is invalid": {
+			msg:      NewVestingTransferMsg("userA", "userB", types.LNO("-1900"), 10, 3600, memo1),
+			wantCode: types.CodeInvalidCoins,
+		},
+		"invalid vesting transfer - zero times": {
+			msg:      NewVestingTransferMsg("userA", "userB", types.LNO("1900"), 0, 3600, memo1),
+			wantCode: types.CodeInvalidVestingSchedule,
+		},
+		"invalid vesting transfer - too many times": {
+			msg: NewVestingTransferMsg(
+				"userA", "userB", types.LNO("1900"), types.MaximumVestingTransferTimes+1, 3600, memo1),
+			wantCode: types.CodeInvalidVestingSchedule,
+		},
+		"invalid vesting transfer - negative interval": {
+			msg:      NewVestingTransferMsg("userA", "userB", types.LNO("1900"), 10, -1, memo1),
+			wantCode: types.CodeInvalidVestingSchedule,
+		},
+		"invalid vesting transfer - memo is invalid": {
+			msg:      NewVestingTransferMsg("userA", "userB", types.LNO("1900"), 10, 3600, invalidMemo),
+			wantCode: types.CodeInvalidMemo,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
+func TestCancelVestingTransferMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      CancelVestingTransferMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case": {
+			msg:      NewCancelVestingTransferMsg("userA", 1),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid username": {
+			msg:      NewCancelVestingTransferMsg("u", 1),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid vesting id": {
+			msg:      NewCancelVestingTransferMsg("userA", 0),
+			wantCode: types.CodeInvalidVestingSchedule,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
 func TestRecoverMsg(t *testing.T) {
+	pubKeys := []crypto.PubKey{
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	tooManyPubKeys := []crypto.PubKey{}
+	for i := 0; i <= types.MaximumMultisigPubKeys; i++ {
+		tooManyPubKeys = append(tooManyPubKeys, secp256k1.GenPrivKey().PubKey())
+	}
 	testCases := map[string]struct {
 		msg      RecoverMsg
 		wantCode sdk.CodeType
@@ -108,6 +209,45 @@ func TestRecoverMsg(t *testing.T) {
 			),
 			wantCode: types.CodeInvalidUsername,
 		},
+		"multisig transaction key": {
+			msg: NewRecoverMsg("test", secp256k1.GenPrivKey().PubKey(),
+				multisig.NewPubKeyMultisigThreshold(2, pubKeys), secp256k1.GenPrivKey().PubKey(),
+			),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid recover - multisig threshold is zero": {
+			msg: NewRecoverMsg("test", secp256k1.GenPrivKey().PubKey(),
+				multisig.PubKeyMultisigThreshold{K: 0, PubKeys: pubKeys}, secp256k1.GenPrivKey().PubKey(),
+			),
+			wantCode: types.CodeInvalidMultisigPubKey,
+		},
+		"invalid recover - multisig threshold is unreachable": {
+			msg: NewRecoverMsg("test", secp256k1.GenPrivKey().PubKey(),
+				multisig.PubKeyMultisigThreshold{K: 4, PubKeys: pubKeys}, secp256k1.GenPrivKey().PubKey(),
+			),
+			wantCode: types.CodeInvalidMultisigPubKey,
+		},
+		"invalid recover - multisig has too many keys": {
+			msg: NewRecoverMsg("test", secp256k1.GenPrivKey().PubKey(),
+				multisig.NewPubKeyMultisigThreshold(2, tooManyPubKeys), secp256k1.GenPrivKey().PubKey(),
+			),
+			wantCode: types.CodeInvalidMultisigPubKey,
+		},
+		"invalid recover - multisig has duplicated keys": {
+			msg: NewRecoverMsg("test", secp256k1.GenPrivKey().PubKey(),
+				multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{pubKeys[0], pubKeys[1], pubKeys[0]}),
+				secp256k1.GenPrivKey().PubKey(),
+			),
+			wantCode: types.CodeInvalidMultisigPubKey,
+		},
+		"invalid recover - nested multisig key": {
+			msg: NewRecoverMsg("test", secp256k1.GenPrivKey().PubKey(),
+				multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{
+					pubKeys[0], multisig.NewPubKeyMultisigThreshold(2, pubKeys)}),
+				secp256k1.GenPrivKey().PubKey(),
+			),
+			wantCode: types.CodeInvalidMultisigPubKey,
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -310,6 +450,14 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewUpdateAccountMsg("user", "{'test':'test'}"),
 			expectPermission: types.AppPermission,
 		},
+		"vesting transfer": {
+			msg:              NewVestingTransferMsg("test", "test_user", types.LNO("1"), 1, 1, "memo"),
+			expectPermission: types.TransactionPermission,
+		},
+		"cancel vesting transfer": {
+			msg:              NewCancelVestingTransferMsg("test", 1),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for testName, tc := range cases {
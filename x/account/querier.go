@@ -2,6 +2,7 @@ package account
 
 import (
 	"encoding/hex"
+	"strconv"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +30,12 @@ const (
 	QueryAccountGrantPubKeys    = "grantPubKey"
 	QueryAccountAllGrantPubKeys = "allGrantPubKey"
 	QueryTxAndAccountSequence   = "txAndSeq"
+	QueryAccountBalanceHistory  = "balanceHistory"
+	QueryVestingTransfers       = "vestingTransfers"
+	QueryVestingTransfer        = "vestingTransfer"
+
+	// MaxBalanceHistoryPageSize - the most balance history details returned by one query
+	MaxBalanceHistoryPageSize int64 = 100
 )
 
 // creates a querier for account REST endpoints
@@ -53,6 +60,12 @@ func NewQuerier(am AccountManager) sdk.Querier {
 			return queryAccountAllGrantPubKeys(ctx, cdc, path[1:], req, am)
 		case QueryTxAndAccountSequence:
 			return queryTxAndSequenceNumber(ctx, cdc, path[1:], req, am)
+		case QueryAccountBalanceHistory:
+			return queryAccountBalanceHistory(ctx, cdc, path[1:], req, am)
+		case QueryVestingTransfers:
+			return queryVestingTransfers(ctx, cdc, path[1:], req, am)
+		case QueryVestingTransfer:
+			return queryVestingTransfer(ctx, cdc, path[1:], req, am)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown account query endpoint")
 		}
@@ -201,3 +214,69 @@ func queryAccountAllGrantPubKeys(ctx sdk.Context, cdc *wire.Codec, path []string
 	}
 	return res, nil
 }
+
+// queryAccountBalanceHistory - path: username[/before[/limit]], details are returned newest first,
+// pass next of the result as before to get the following page.
+func queryAccountBalanceHistory(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	before, limit := int64(0), MaxBalanceHistoryPageSize
+	if len(path) > 1 {
+		v, err := strconv.ParseInt(path[1], 10, 64)
+		if err != nil {
+			return nil, types.ErrInvalidQueryPath()
+		}
+		before = v
+	}
+	if len(path) > 2 {
+		v, err := strconv.ParseInt(path[2], 10, 64)
+		if err != nil || v <= 0 {
+			return nil, types.ErrInvalidQueryPath()
+		}
+		limit = min(v, MaxBalanceHistoryPageSize)
+	}
+	page, err := am.GetBalanceHistory(ctx, types.AccountKey(path[0]), before, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryVestingTransfers(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	transfers, err := am.GetVestingTransfers(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(transfers)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryVestingTransfer(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	id, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, types.ErrInvalidQueryPath()
+	}
+	transfer, err := am.GetVestingTransfer(ctx, types.AccountKey(path[0]), id)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(transfer)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -11,6 +11,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ClaimMsg{}, "lino/claim", nil)
 	cdc.RegisterConcrete(RecoverMsg{}, "lino/recover", nil)
 	cdc.RegisterConcrete(UpdateAccountMsg{}, "lino/updateAcc", nil)
+	cdc.RegisterConcrete(VestingTransferMsg{}, "lino/vestingTransfer", nil)
+	cdc.RegisterConcrete(CancelVestingTransferMsg{}, "lino/cancelVestingTransfer", nil)
 }
 
 var msgCdc = wire.New()
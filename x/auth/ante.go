@@ -3,13 +3,18 @@ package auth
 import (
 	"fmt"
 
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
 
+	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
 	post "github.com/lino-network/lino/x/post"
 )
 
@@ -64,9 +69,81 @@ func GetMsgDonationValidAmount(ctx sdk.Context, msg types.Msg, am acc.AccountMan
 	return rst
 }
 
+// describeMultisig - number of signatures against threshold if @p pubKey is a
+// threshold multisig key, empty for other keys.
+func describeMultisig(pubKey crypto.PubKey, sig []byte) string {
+	multisigKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return ""
+	}
+	var mSig multisig.Multisignature
+	if err := wire.New().UnmarshalBinaryBare(sig, &mSig); err != nil {
+		return ", malformed multisig signature"
+	}
+	return fmt.Sprintf(", multisig signed:%d, threshold:%d of %d",
+		len(mSig.Sigs), multisigKey.K, len(multisigKey.PubKeys))
+}
+
+// GetTxFee - return the fee attached to @p stdTx and if it can substitute for TPS capacity.
+// Fee is only considered when enabled by bandwidth param, it must be at least min gas price
+// times the capacity a transaction would consume, otherwise it is ignored.
+func GetTxFee(
+	ctx sdk.Context, stdTx auth.StdTx, gm global.GlobalManager, ph param.ParamHolder) (types.Coin, bool, sdk.Error) {
+	zero := types.NewCoinFromInt64(0)
+	bandwidthParam, err := ph.GetBandwidthParam(ctx)
+	if err != nil {
+		return zero, false, err
+	}
+	fee := types.Coin{Amount: stdTx.Fee.Amount.AmountOf(types.TxFeeDenom)}
+	if !bandwidthParam.FeeEnabled || !fee.IsPositive() {
+		return zero, false, nil
+	}
+	tpsCapacityRatio, err := gm.GetTPSCapacityRatio(ctx)
+	if err != nil {
+		return zero, false, err
+	}
+	required := types.DecToCoin(bandwidthParam.CapacityUsagePerTransaction.ToDec().
+		Mul(tpsCapacityRatio).Mul(bandwidthParam.MinGasPrice))
+	if !fee.IsGTE(required) {
+		return zero, false, nil
+	}
+	return fee, true, nil
+}
+
+// GetSigningApp - return the app named by @p msgs, whose app key must be @p pubKey.
+func GetSigningApp(
+	ctx sdk.Context, msgs []sdk.Msg, pubKey crypto.PubKey,
+	am acc.AccountManager, dm dev.DeveloperManager) (types.AccountKey, sdk.Error) {
+	app := types.AccountKey("")
+	for _, msg := range msgs {
+		appMsg, ok := msg.(types.AppMsg)
+		if !ok || appMsg.GetFromApp() == "" {
+			continue
+		}
+		if app != "" && app != appMsg.GetFromApp() {
+			return "", ErrUnverifiedAppSig("msgs are sent through different apps")
+		}
+		app = appMsg.GetFromApp()
+	}
+	if app == "" {
+		return "", ErrUnverifiedAppSig("no app is named by msgs")
+	}
+	if !dm.DoesDeveloperExist(ctx, app) {
+		return "", ErrUnverifiedAppSig(fmt.Sprintf("app %v is not a developer", app))
+	}
+	appKey, err := am.GetAppKey(ctx, app)
+	if err != nil {
+		return "", err
+	}
+	if !appKey.Equals(pubKey) {
+		return "", ErrUnverifiedAppSig(fmt.Sprintf("signing key is not app key of %v", app))
+	}
+	return app, nil
+}
+
 // NewAnteHandler - return an AnteHandler
 func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
-	pm post.PostManager) sdk.AnteHandler {
+	pm post.PostManager, dm dev.DeveloperManager, ph param.ParamHolder) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (_ sdk.Context, _ sdk.Result, abort bool) {
@@ -92,6 +169,13 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 		}
 
 		fee := stdTx.Fee
+		// fee attached by the first signer substitutes for TPS capacity of all signers,
+		// without a sufficient fee signers fall back to TPS capacity check.
+		txFee, feeSufficient, err := GetTxFee(ctx, stdTx, gm, ph)
+		if err != nil {
+			return ctx, err.Result(), true
+		}
+		feeUsed := false
 
 		sdkMsgs := tx.GetMsgs()
 
@@ -101,6 +185,13 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 				signers = append(signers, signer)
 			}
 		}
+		// an extra signature after signers' is the co-signature of the app
+		// the transaction comes through.
+		var appSig *auth.StdSignature
+		if len(sigs) == len(signers)+1 {
+			appSig = &sigs[len(signers)]
+			sigs = sigs[:len(signers)]
+		}
 		if len(signers) != len(sigs) {
 			return ctx,
 				ErrWrongNumberOfSigners().Result(),
@@ -108,6 +199,7 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 		}
 		// signers get from msg should be verify first
 		var idx = 0
+		var firstSignBytes []byte
 		for _, msg := range sdkMsgs {
 			msg, ok := msg.(types.Msg)
 			if !ok {
@@ -123,20 +215,25 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 					return ctx, err.Result(), true
 				}
 				donationAmount := GetMsgDonationAmount(msg)
-				if ctx.BlockHeader().Height >= types.BlockchainUpgrade1Update4Height {
+				if ph.IsFeatureActive(ctx, types.FeatureDonationBandwidthFix) {
 					donationAmount = GetMsgDonationValidAmount(ctx, msg, am, pm)
 				}
-				// enable no-cost-donation starting BlockchainUpgrade1Update1Height
-				if ctx.BlockHeader().Height < types.BlockchainUpgrade1Update1Height ||
+				// enable no-cost-donation since FeatureNoCostDonation is active
+				if !ph.IsFeatureActive(ctx, types.FeatureNoCostDonation) ||
 					!donationAmount.IsGTE(types.NewCoinFromInt64(types.NoTPSLimitDonationMin)) {
-					// get current tps
-					tpsCapacityRatio, err := gm.GetTPSCapacityRatio(ctx)
-					if err != nil {
-						return ctx, err.Result(), true
-					}
-					// check user tps capacity
-					if err = am.CheckUserTPSCapacity(ctx, types.AccountKey(msgSigner), tpsCapacityRatio); err != nil {
-						return ctx, err.Result(), true
+					if feeSufficient {
+						// fee is used in place of TPS capacity.
+						feeUsed = true
+					} else {
+						// get current tps
+						tpsCapacityRatio, err := gm.GetTPSCapacityRatio(ctx)
+						if err != nil {
+							return ctx, err.Result(), true
+						}
+						// check user tps capacity
+						if err = am.CheckUserTPSCapacity(ctx, types.AccountKey(msgSigner), tpsCapacityRatio); err != nil {
+							return ctx, err.Result(), true
+						}
 					}
 				}
 				// construct sign bytes and verify sequence number.
@@ -145,11 +242,14 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 					return ctx, err.Result(), true
 				}
 				signBytes := auth.StdSignBytes(ctx.ChainID(), uint64(0), uint64(seq), fee, sdkMsgs, stdTx.GetMemo())
-				// verify signature
+				if idx == 0 {
+					firstSignBytes = signBytes
+				}
+				// verify signature, a threshold multisig key verifies the aggregated signature.
 				if !sigs[idx].PubKey.VerifyBytes(signBytes, sigs[idx].Signature) {
 					return ctx, ErrUnverifiedBytes(
-						fmt.Sprintf("signature verification failed, chain-id:%v, seq:%d",
-							ctx.ChainID(), seq)).Result(), true
+						fmt.Sprintf("signature verification failed, chain-id:%v, seq:%d%s",
+							ctx.ChainID(), seq, describeMultisig(sigs[idx].PubKey, sigs[idx].Signature))).Result(), true
 				}
 				// succ
 				if err := am.IncreaseSequenceByOne(ctx, types.AccountKey(msgSigner)); err != nil {
@@ -160,8 +260,29 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager,
 				idx++
 			}
 		}
+		// charge fee from first signer only if it is used, proceeds go to validator inflation pool.
+		if feeUsed {
+			if err := am.MinusSavingCoin(
+				ctx, types.AccountKey(signers[0]), txFee, "", "", types.TransactionFee); err != nil {
+				return ctx, err.Result(), true
+			}
+			if err := gm.AddToValidatorInflationPool(ctx, txFee); err != nil {
+				return ctx, err.Result(), true
+			}
+		}
 
-		// TODO(Lino): verify application signature.
+		// app named by msgs signs the same bytes as the first signer.
+		if appSig != nil {
+			app, err := GetSigningApp(ctx, sdkMsgs, appSig.PubKey, am, dm)
+			if err != nil {
+				return ctx, err.Result(), true
+			}
+			if !appSig.PubKey.VerifyBytes(firstSignBytes, appSig.Signature) {
+				return ctx, ErrUnverifiedAppSig(
+					fmt.Sprintf("signature verification failed, app:%v", app)).Result(), true
+			}
+			ctx = types.WithApp(ctx, app)
+		}
 		return ctx, sdk.Result{}, false
 	}
 }
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	abci "github.com/tendermint/tendermint/abci/types"
 	crypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
@@ -19,6 +21,7 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
 	"github.com/lino-network/lino/x/global"
 	post "github.com/lino-network/lino/x/post"
 )
@@ -27,9 +30,11 @@ type TestMsg struct {
 	Signers    []types.AccountKey
 	Permission types.Permission
 	Amount     types.Coin
+	FromApp    types.AccountKey
 }
 
 var _ types.Msg = TestMsg{}
+var _ types.AppMsg = TestMsg{}
 
 func (msg TestMsg) Route() string                   { return "normal msg" }
 func (msg TestMsg) Type() string                    { return "normal msg" }
@@ -52,6 +57,9 @@ func (msg TestMsg) GetSigners() []sdk.AccAddress {
 func (msg TestMsg) GetConsumeAmount() types.Coin {
 	return msg.Amount
 }
+func (msg TestMsg) GetFromApp() types.AccountKey {
+	return msg.FromApp
+}
 
 func newTestMsg(accKeys ...types.AccountKey) TestMsg {
 	return TestMsg{
@@ -63,15 +71,20 @@ func newTestMsg(accKeys ...types.AccountKey) TestMsg {
 
 func newTestTx(
 	ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, seqs []uint64) sdk.Tx {
+	return newTestTxWithFee(ctx, msgs, privs, seqs, auth.StdFee{})
+}
+
+func newTestTxWithFee(
+	ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, seqs []uint64, fee auth.StdFee) sdk.Tx {
 	sigs := make([]auth.StdSignature, len(privs))
 
 	for i, priv := range privs {
-		signBytes := auth.StdSignBytes(ctx.ChainID(), 0, seqs[i], auth.StdFee{}, msgs, "")
+		signBytes := auth.StdSignBytes(ctx.ChainID(), 0, seqs[i], fee, msgs, "")
 		bz, _ := priv.Sign(signBytes)
 		sigs[i] = auth.StdSignature{
 			PubKey: priv.PubKey(), Signature: bz}
 	}
-	tx := auth.NewStdTx(msgs, auth.StdFee{}, sigs, "")
+	tx := auth.NewStdTx(msgs, fee, sigs, "")
 	return tx
 }
 
@@ -84,6 +97,7 @@ type AnteTestSuite struct {
 	am   acc.AccountManager
 	pm   post.PostManager
 	gm   global.GlobalManager
+	dm   dev.DeveloperManager
 	ph   param.ParamHolder
 	ctx  sdk.Context
 	ante sdk.AnteHandler
@@ -94,6 +108,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	TestPostKVStoreKey := sdk.NewKVStoreKey("post")
 	TestGlobalKVStoreKey := sdk.NewKVStoreKey("global")
 	TestParamKVStoreKey := sdk.NewKVStoreKey("param")
+	TestDeveloperKVStoreKey := sdk.NewKVStoreKey("developer")
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -101,6 +116,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	ms.MountStoreWithDB(TestPostKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(TestGlobalKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(TestParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(TestDeveloperKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 	ctx := sdk.NewContext(
 		ms, abci.Header{ChainID: "Lino", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
@@ -110,12 +126,15 @@ func (suite *AnteTestSuite) SetupTest() {
 	am := acc.NewAccountManager(TestAccountKVStoreKey, ph)
 	pm := post.NewPostManager(TestPostKVStoreKey, ph)
 	gm := global.NewGlobalManager(TestGlobalKVStoreKey, ph)
+	dm := dev.NewDeveloperManager(TestDeveloperKVStoreKey, ph)
 	initGlobalManager(ctx, gm)
-	anteHandler := NewAnteHandler(am, gm, pm)
+	dm.InitGenesis(ctx)
+	anteHandler := NewAnteHandler(am, gm, pm, dm, ph)
 
 	suite.am = am
 	suite.pm = pm
 	suite.gm = gm
+	suite.dm = dm
 	suite.ph = ph
 	suite.ctx = ctx
 	suite.ante = anteHandler
@@ -145,7 +164,7 @@ func (suite *AnteTestSuite) createTestPost(postid string, author types.AccountKe
 	err := suite.pm.CreatePost(
 		suite.ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content,
-		msg.Title, sdk.ZeroDec(), msg.Links)
+		msg.Title, sdk.ZeroDec(), msg.Links, nil)
 	suite.Require().Nil(err)
 }
 
@@ -231,6 +250,49 @@ func (suite *AnteTestSuite) TestAnteHandlerNormalTx() {
 	suite.checkInvalidTx(tx, ErrWrongNumberOfSigners().Result())
 }
 
+// Test threshold multisig transaction key.
+func (suite *AnteTestSuite) TestMultisigTransactionKey() {
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := make([]crypto.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	accParams, _ := suite.ph.GetAccountParam(suite.ctx)
+	user := types.AccountKey("treasury")
+	suite.am.CreateAccount(suite.ctx, "referrer", user, secp256k1.GenPrivKey().PubKey(),
+		multisigKey, secp256k1.GenPrivKey().PubKey(), accParams.RegisterFee)
+
+	msg := newTestMsg(user)
+	msg.Permission = types.TransactionPermission
+	msgs := []sdk.Msg{msg}
+	newMultisigTx := func(signers []int, seq uint64) sdk.Tx {
+		signBytes := auth.StdSignBytes(suite.ctx.ChainID(), 0, seq, auth.StdFee{}, msgs, "")
+		mSig := multisig.NewMultisig(len(pubKeys))
+		for _, i := range signers {
+			bz, _ := privs[i].Sign(signBytes)
+			suite.Nil(mSig.AddSignatureFromPubKey(bz, pubKeys[i], pubKeys))
+		}
+		sigs := []auth.StdSignature{{PubKey: multisigKey, Signature: mSig.Marshal()}}
+		return auth.NewStdTx(msgs, auth.StdFee{}, sigs, "")
+	}
+
+	// signatures below threshold
+	suite.checkInvalidTx(newMultisigTx([]int{1}, 0), ErrUnverifiedBytes(
+		"signature verification failed, chain-id:Lino, seq:0, multisig signed:1, threshold:2 of 3").Result())
+
+	// signatures reach threshold
+	suite.checkValidTx(newMultisigTx([]int{0, 2}, 0))
+	suite.checkValidTx(newMultisigTx([]int{0, 1, 2}, 1))
+	seq, err := suite.am.GetSequence(suite.ctx, user)
+	suite.Nil(err)
+	suite.Equal(uint64(2), seq)
+
+	// a single key of multisig key can't sign alone
+	tx := newTestTx(suite.ctx, msgs, []crypto.PrivKey{privs[0]}, []uint64{2})
+	suite.checkInvalidTx(tx, acc.ErrCheckTransactionKey().Result())
+}
+
 // Test grant authentication.
 func (suite *AnteTestSuite) TestGrantAuthenticationTx() {
 	// keys and username
@@ -342,6 +404,138 @@ func (suite *AnteTestSuite) TestTPSCapacity() {
 	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
 }
 
+// Test app co-signature is verified against app key of developer.
+func (suite *AnteTestSuite) TestAppCoSignature() {
+	_, transaction1, _, user1 := suite.createTestAccount("user1")
+	_, _, appKey, app := suite.createTestAccount("app")
+	_, _, otherAppKey, _ := suite.createTestAccount("notapp")
+	developerParam, err := suite.ph.GetDeveloperParam(suite.ctx)
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.dm.RegisterDeveloper(
+		suite.ctx, app, developerParam.DeveloperMinDeposit, "", "", ""))
+
+	msg := newTestMsg(user1)
+	msg.FromApp = app
+	privs := []crypto.PrivKey{transaction1}
+	// co-sign same bytes as the first signer.
+	coSign := func(tx sdk.Tx, seq uint64, priv crypto.PrivKey) sdk.Tx {
+		stdTx := tx.(auth.StdTx)
+		signBytes := auth.StdSignBytes(suite.ctx.ChainID(), 0, seq, stdTx.Fee, stdTx.GetMsgs(), "")
+		sig, _ := priv.Sign(signBytes)
+		stdTx.Signatures = append(stdTx.Signatures, auth.StdSignature{PubKey: priv.PubKey(), Signature: sig})
+		return stdTx
+	}
+
+	// tx without co-signature has no app
+	tx := newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{0})
+	newCtx, result, abort := suite.ante(suite.ctx, tx, false)
+	suite.False(abort)
+	suite.True(result.IsOK())
+	suite.Equal(types.AccountKey(""), types.GetApp(newCtx))
+
+	// co-signed by app key of developer
+	tx = coSign(newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{1}), 1, appKey)
+	newCtx, result, abort = suite.ante(suite.ctx, tx, false)
+	suite.False(abort)
+	suite.True(result.IsOK())
+	suite.Equal(app, types.GetApp(newCtx))
+
+	// co-signed by key other than app key of the named app
+	tx = coSign(newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{2}), 2, otherAppKey)
+	suite.checkInvalidTx(tx, ErrUnverifiedAppSig(
+		fmt.Sprintf("signing key is not app key of %v", app)).Result())
+
+	// co-signature over different bytes, sequence is increased by failed tx above.
+	tx = coSign(newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{3}), 2, appKey)
+	suite.checkInvalidTx(tx, ErrUnverifiedAppSig(
+		fmt.Sprintf("signature verification failed, app:%v", app)).Result())
+
+	// co-signed without naming the app
+	noAppMsg := newTestMsg(user1)
+	tx = coSign(newTestTx(suite.ctx, []sdk.Msg{noAppMsg}, privs, []uint64{4}), 4, appKey)
+	suite.checkInvalidTx(tx, ErrUnverifiedAppSig("no app is named by msgs").Result())
+
+	// named app is not a developer
+	notAppMsg := newTestMsg(user1)
+	notAppMsg.FromApp = "notapp"
+	tx = coSign(newTestTx(suite.ctx, []sdk.Msg{notAppMsg}, privs, []uint64{5}), 5, otherAppKey)
+	suite.checkInvalidTx(tx, ErrUnverifiedAppSig("app notapp is not a developer").Result())
+
+	// msgs name different apps
+	_, err = GetSigningApp(suite.ctx, []sdk.Msg{msg, notAppMsg}, appKey.PubKey(), suite.am, suite.dm)
+	suite.Equal(ErrUnverifiedAppSig("msgs are sent through different apps"), err)
+}
+
+// Test sufficient fee substitutes for exhausted TPS capacity once enabled by bandwidth param.
+func (suite *AnteTestSuite) TestTxFee() {
+	_, transaction1, _, user1 := suite.createTestAccount("user1")
+	suite.am.AddSavingCoin(
+		suite.ctx, user1, types.NewCoinFromInt64(100*types.Decimals), "", "", types.TransferIn)
+	msg := newTestMsg(user1)
+	privs := []crypto.PrivKey{transaction1}
+	feeOf := func(amount int64) auth.StdFee {
+		return auth.StdFee{Amount: sdk.Coins{sdk.NewInt64Coin(types.TxFeeDenom, amount)}}
+	}
+
+	// fee is ignored before enabled
+	savingBefore, err := suite.am.GetSavingFromBank(suite.ctx, user1)
+	suite.Nil(err)
+	tx := newTestTxWithFee(suite.ctx, []sdk.Msg{msg}, privs, []uint64{0}, feeOf(1))
+	suite.checkValidTx(tx)
+	saving, err := suite.am.GetSavingFromBank(suite.ctx, user1)
+	suite.Nil(err)
+	suite.Equal(savingBefore, saving)
+
+	bandwidthParam, err := suite.ph.GetBandwidthParam(suite.ctx)
+	suite.Nil(err)
+	bandwidthParam.FeeEnabled = true
+	suite.Nil(param.ChangeParamEvent{Param: *bandwidthParam}.Execute(suite.ctx, suite.ph))
+
+	// exhaust TPS capacity
+	suite.ctx = suite.ctx.WithBlockHeader(
+		abci.Header{ChainID: "Lino", Height: 2, Time: time.Now(), NumTxs: 1000})
+	suite.gm.SetLastBlockTime(suite.ctx, time.Now().Unix()-1)
+	suite.gm.UpdateTPS(suite.ctx)
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{1})
+	suite.checkValidTx(tx)
+	tx = newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{2})
+	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
+
+	tpsCapacityRatio, err := suite.gm.GetTPSCapacityRatio(suite.ctx)
+	suite.Nil(err)
+	required := types.DecToCoin(bandwidthParam.CapacityUsagePerTransaction.ToDec().
+		Mul(tpsCapacityRatio).Mul(bandwidthParam.MinGasPrice))
+	requiredInt64, err := required.ToInt64()
+	suite.Nil(err)
+
+	// fee less than min gas price is ignored, TPS capacity is checked
+	tx = newTestTxWithFee(suite.ctx, []sdk.Msg{msg}, privs, []uint64{2}, feeOf(requiredInt64-1))
+	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
+	saving, err = suite.am.GetSavingFromBank(suite.ctx, user1)
+	suite.Nil(err)
+	suite.Equal(savingBefore, saving)
+
+	// fee paid, TPS capacity is not checked
+	tx = newTestTxWithFee(suite.ctx, []sdk.Msg{msg}, privs, []uint64{2}, feeOf(requiredInt64))
+	suite.checkValidTx(tx)
+	saving, err = suite.am.GetSavingFromBank(suite.ctx, user1)
+	suite.Nil(err)
+	suite.Equal(savingBefore.Minus(required), saving)
+
+	// fee is not charged if TPS capacity is not checked for no-cost donation
+	suite.createTestAccount("user2")
+	suite.createTestPost("post1", "user2")
+	suite.ctx = suite.ctx.WithBlockHeader(
+		abci.Header{ChainID: "Lino", Height: types.BlockchainUpgrade1Update1Height,
+			Time: time.Now(), NumTxs: 1000})
+	donateMsg := post.NewDonateMsg("user1", types.LNO("1"), "user2", "post1", "", "memee")
+	tx = newTestTxWithFee(suite.ctx, []sdk.Msg{donateMsg}, privs, []uint64{3}, feeOf(requiredInt64))
+	suite.checkValidTx(tx)
+	saving, err = suite.am.GetSavingFromBank(suite.ctx, user1)
+	suite.Nil(err)
+	suite.Equal(savingBefore.Minus(required), saving)
+}
+
 // before BlockchainUpgrade1Update1Height donation cost bandwidth.
 func (suite *AnteTestSuite) TestTPSCapacityDonationBeforeUpdate1() {
 	// keys and username
@@ -33,6 +33,11 @@ func ErrWrongNumberOfSigners() sdk.Error {
 // 	return types.NewError(types.CodeInvalidSequence, fmt.Sprintf("msg: %v", msg))
 // }
 
+// ErrUnverifiedAppSig - error if app co-signature verification failed
+func ErrUnverifiedAppSig(msg string) sdk.Error {
+	return types.NewError(types.CodeUnverifiedAppSig, fmt.Sprintf("app signature: %v", msg))
+}
+
 // ErrUnverifiedBytes - error if signbyte verification failed
 func ErrUnverifiedBytes(msg string) sdk.Error {
 	return types.NewError(types.CodeUnverifiedBytes, fmt.Sprintf("msg: %v", msg))
@@ -58,7 +58,7 @@ func handleDeveloperRegisterMsg(
 		ctx, msg.Username, deposit, msg.Website, msg.Description, msg.AppMetaData); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Username, "", deposit, types.DeveloperDeposit)}
 }
 
 func handleDeveloperUpdateMsg(
@@ -75,7 +75,7 @@ func handleDeveloperUpdateMsg(
 		ctx, msg.Username, msg.Website, msg.Description, msg.AppMetaData); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Username)}
 }
 
 func handleDeveloperRevokeMsg(
@@ -103,7 +103,7 @@ func handleDeveloperRevokeMsg(
 		ctx, msg.Username, gm, am, param.DeveloperCoinReturnTimes, param.DeveloperCoinReturnIntervalSec, coin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags("", msg.Username, coin, types.DeveloperReturnCoin)}
 }
 
 func handleGrantPermissionMsg(
@@ -146,7 +146,8 @@ func handleGrantPermissionMsg(
 	default:
 		return ErrInvalidGrantPermission().Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: sdk.NewTags(
+		types.TagSender, string(msg.Username), types.TagReceiver, string(msg.AuthorizedApp))}
 }
 
 func handleRevokePermissionMsg(
@@ -158,7 +159,8 @@ func handleRevokePermissionMsg(
 	if err := am.RevokePermission(ctx, msg.Username, msg.RevokeFrom, msg.Permission); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: sdk.NewTags(
+		types.TagSender, string(msg.Username), types.TagReceiver, string(msg.RevokeFrom))}
 }
 
 func handlePreAuthorizationMsg(
@@ -179,7 +181,8 @@ func handlePreAuthorizationMsg(
 		ctx, msg.Username, msg.AuthorizedApp, msg.ValidityPeriodSec, types.PreAuthorizationPermission, amount); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: sdk.NewTags(
+		types.TagSender, string(msg.Username), types.TagReceiver, string(msg.AuthorizedApp))}
 }
 
 func returnCoinTo(
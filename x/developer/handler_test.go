@@ -123,7 +123,8 @@ func TestRevokeBasic(t *testing.T) {
 
 	msg2 := NewDeveloperRevokeMsg("developer1")
 	res2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, res2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", "developer1", devParam.DeveloperMinDeposit, types.DeveloperReturnCoin)}, res2)
 	// check acc1's depoist has not been added back
 	acc1Saving, _ := am.GetSavingFromBank(ctx, types.AccountKey("developer1"))
 	assert.Equal(t, true, acc1Saving.IsEqual(minBalance))
@@ -218,3 +218,13 @@ func (dm DeveloperManager) Export(ctx sdk.Context) *model.DeveloperTables {
 func (dm DeveloperManager) Import(ctx sdk.Context, tb *model.DeveloperTablesIR) {
 	dm.storage.Import(ctx, tb)
 }
+
+// ExportRows - stream state of storage row by row
+func (dm DeveloperManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	dm.storage.ExportRows(ctx, write)
+}
+
+// ImportRow - import state of storage row by row
+func (dm DeveloperManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	dm.storage.ImportRow(ctx, table, read)
+}
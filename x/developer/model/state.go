@@ -4,6 +4,12 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// table names of developer storage, used in streaming export and import.
+const (
+	DeveloperTable         = "developers"
+	DeveloperListTableName = "developer_list"
+)
+
 // DeveloperRow - pk: Username
 type DeveloperRow struct {
 	Username  types.AccountKey `json:"username"`
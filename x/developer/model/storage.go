@@ -104,34 +104,47 @@ func (ds DeveloperStorage) SetDeveloperList(ctx sdk.Context, lst *DeveloperList)
 // Export developer storage state
 func (ds DeveloperStorage) Export(ctx sdk.Context) *DeveloperTables {
 	tables := &DeveloperTables{}
+	ds.iterateDeveloperRows(ctx, func(row DeveloperRow) {
+		tables.Developers = append(tables.Developers, row)
+	})
+	tables.DeveloperList = ds.developerListTable(ctx)
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (ds DeveloperStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	ds.iterateDeveloperRows(ctx, func(row DeveloperRow) {
+		write(DeveloperTable, row)
+	})
+	write(DeveloperListTableName, ds.developerListTable(ctx))
+}
+
+func (ds DeveloperStorage) iterateDeveloperRows(ctx sdk.Context, process func(DeveloperRow)) {
 	store := ctx.KVStore(ds.key)
-	// export table.Developers
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, developerSubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			username := types.AccountKey(k[1:])
-			dev, err := ds.GetDeveloper(ctx, username)
-			if err != nil {
-				panic("failed to read developer: " + err.Error())
-			}
-			row := DeveloperRow{
-				Username:  username,
-				Developer: *dev,
-			}
-			tables.Developers = append(tables.Developers, row)
+	itr := sdk.KVStorePrefixIterator(store, developerSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		username := types.AccountKey(k[1:])
+		dev, err := ds.GetDeveloper(ctx, username)
+		if err != nil {
+			panic("failed to read developer: " + err.Error())
 		}
-	}()
-	// export table.DeveloperList
+		process(DeveloperRow{
+			Username:  username,
+			Developer: *dev,
+		})
+	}
+}
+
+func (ds DeveloperStorage) developerListTable(ctx sdk.Context) DeveloperListTable {
 	list, err := ds.GetDeveloperList(ctx)
 	if err != nil {
 		panic("failed to get developer list: " + err.Error())
 	}
-	tables.DeveloperList = DeveloperListTable{
+	return DeveloperListTable{
 		List: *list,
 	}
-	return tables
 }
 
 // Import from tablesIR.
@@ -151,6 +164,27 @@ func (ds DeveloperStorage) Import(ctx sdk.Context, tb *DeveloperTablesIR) {
 	check(err)
 }
 
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (ds DeveloperStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[ds] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case DeveloperTable:
+		row := DeveloperRow{}
+		check(read(&row))
+		check(ds.SetDeveloper(ctx, row.Username, &row.Developer))
+	case DeveloperListTableName:
+		row := DeveloperListTable{}
+		check(read(&row))
+		check(ds.SetDeveloperList(ctx, &row.List))
+	default:
+		panic("[ds] Failed to import: unknown table " + table)
+	}
+}
+
 // GetDeveloperKey - "developer substore" + "developer"
 func GetDeveloperKey(accKey types.AccountKey) []byte {
 	return append(developerSubstore, accKey...)
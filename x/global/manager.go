@@ -34,6 +34,16 @@ func (gm *GlobalManager) Import(ctx sdk.Context, tb *model.GlobalTablesIR) {
 	gm.storage.Import(ctx, tb)
 }
 
+// ExportRows - stream state row by row
+func (gm *GlobalManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	gm.storage.ExportRows(ctx, write)
+}
+
+// ImportRow - import state row by row
+func (gm *GlobalManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	gm.storage.ImportRow(ctx, table, read)
+}
+
 // WireCodec - access to global manager codec
 func (gm *GlobalManager) WireCodec() *wire.Codec {
 	return gm.storage.WireCodec()
@@ -320,6 +330,15 @@ func (gm *GlobalManager) RegisterParamChangeEvent(ctx sdk.Context, event types.E
 	return nil
 }
 
+// RegisterSubscriptionEvent - register subscription payment event at @p unixTime
+func (gm *GlobalManager) RegisterSubscriptionEvent(
+	ctx sdk.Context, unixTime int64, event types.Event) sdk.Error {
+	if err := gm.registerEventAtTime(ctx, unixTime, event); err != nil {
+		return err
+	}
+	return nil
+}
+
 // DistributeHourlyInflation - distribute inflation hourly
 func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 	// param will be changed in one day
@@ -336,11 +355,11 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 		return err
 	}
 
-	// BlockchainUpgrade1Update2Height
+	// FeatureGrowthRateCorrection
 	// Growth rate in genesis file for testnet-upgrade1 was wrong.
 	// For lino-testnet, the growth rate is, and always was, 0.5%, not 5%.
 	growthRate := globalAllocation.GlobalGrowthRate
-	if ctx.BlockHeight() >= types.BlockchainUpgrade1Update2Height {
+	if gm.paramHolder.IsFeatureActive(ctx, types.FeatureGrowthRateCorrection) {
 		growthRate = types.NewDecFromRat(5, 1000)
 	}
 
@@ -380,7 +399,7 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
 		return err
 	}
-	return nil
+	return gm.AddBalanceChange(ctx, "", thisHourInflation, types.HourlyInflation)
 }
 
 // SetTotalLinoAndRecalculateGrowthRate - recalculate annually inflation based on consumption growth rate
@@ -491,6 +510,24 @@ func (gm *GlobalManager) AddToValidatorInflationPool(ctx sdk.Context, coin types
 	return nil
 }
 
+// AddBalanceChange - record a coin movement initiated by the blockchain in journal of current block
+func (gm *GlobalManager) AddBalanceChange(
+	ctx sdk.Context, username types.AccountKey, coin types.Coin, detailType types.TransferDetailType) sdk.Error {
+	if coin.IsZero() {
+		return nil
+	}
+	return gm.storage.AddBalanceChange(ctx, ctx.BlockHeight(), &model.BalanceChange{
+		Username:   username,
+		Amount:     coin,
+		DetailType: detailType,
+	})
+}
+
+// GetBlockJournal - get all coin movements initiated by the blockchain at given height
+func (gm *GlobalManager) GetBlockJournal(ctx sdk.Context, height int64) (*model.BlockJournal, sdk.Error) {
+	return gm.storage.GetBlockJournal(ctx, height)
+}
+
 // GetValidatorHourlyInflation - get validator hourly inflation
 func (gm *GlobalManager) GetValidatorHourlyInflation(ctx sdk.Context) (types.Coin, sdk.Error) {
 	pool, err := gm.storage.GetInflationPool(ctx)
@@ -623,6 +660,31 @@ func (gm *GlobalManager) CommitEventCache(ctx sdk.Context) sdk.Error {
 	return nil
 }
 
+// SnapshotEventCache - return the number of cached events at each time,
+// events cached after the snapshot can be dropped by RevertEventCache.
+func (gm *GlobalManager) SnapshotEventCache() map[int64]int {
+	snapshot := make(map[int64]int)
+	for _, eventCache := range gm.deliverTxEventCacheList {
+		snapshot[eventCache.UnixTime] = len(eventCache.EventList)
+	}
+	return snapshot
+}
+
+// RevertEventCache - drop events cached after @p snapshot is taken,
+// used when state changes registering them are discarded.
+func (gm *GlobalManager) RevertEventCache(snapshot map[int64]int) {
+	eventCacheList := []*model.EventCache{}
+	for _, eventCache := range gm.deliverTxEventCacheList {
+		n, ok := snapshot[eventCache.UnixTime]
+		if !ok {
+			continue
+		}
+		eventCache.EventList = eventCache.EventList[:n]
+		eventCacheList = append(eventCacheList, eventCache)
+	}
+	gm.deliverTxEventCacheList = eventCacheList
+}
+
 // ClearEventCache - clear event cache
 // clear event cache will only be committed at the beginblocker
 func (gm *GlobalManager) ClearEventCache(ctx sdk.Context) sdk.Error {
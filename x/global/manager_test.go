@@ -486,6 +486,22 @@ func TestTimeEventList(t *testing.T) {
 	}
 }
 
+func TestRevertEventCache(t *testing.T) {
+	ctx, gm := setupTest(t)
+	baseTime := ctx.BlockHeader().Time.Unix()
+
+	assert.Nil(t, gm.registerEventAtTime(ctx, baseTime, testEvent{}))
+	snapshot := gm.SnapshotEventCache()
+	assert.Nil(t, gm.registerEventAtTime(ctx, baseTime, testEvent{}))
+	assert.Nil(t, gm.registerEventAtTime(ctx, baseTime+1, testEvent{}))
+	gm.RevertEventCache(snapshot)
+
+	assert.Nil(t, gm.CommitEventCache(ctx))
+	assert.Equal(t, &types.TimeEventList{Events: []types.Event{testEvent{}}},
+		gm.GetTimeEventListAtTime(ctx, baseTime))
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, baseTime+1))
+}
+
 func TestRegisterCoinReturnEvent(t *testing.T) {
 	ctx, gm := setupTest(t)
 	baseTime := ctx.BlockHeader().Time.Unix()
@@ -1006,6 +1022,35 @@ func TestAddToValidatorInflationPool(t *testing.T) {
 	}
 }
 
+func TestAddBalanceChange(t *testing.T) {
+	ctx, gm := setupTest(t)
+	ctx = ctx.WithBlockHeight(10)
+	user := types.AccountKey("user")
+
+	// zero coin is not recorded
+	err := gm.AddBalanceChange(ctx, user, types.NewCoinFromInt64(0), types.ValidatorInflation)
+	assert.Nil(t, err)
+	journal, err := gm.GetBlockJournal(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(journal.Changes))
+
+	err = gm.AddBalanceChange(ctx, user, types.NewCoinFromInt64(100), types.ValidatorInflation)
+	assert.Nil(t, err)
+	err = gm.AddBalanceChange(ctx, "", types.NewCoinFromInt64(1000), types.HourlyInflation)
+	assert.Nil(t, err)
+	journal, err = gm.GetBlockJournal(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.BalanceChange{
+		{Username: user, Amount: types.NewCoinFromInt64(100), DetailType: types.ValidatorInflation},
+		{Username: "", Amount: types.NewCoinFromInt64(1000), DetailType: types.HourlyInflation},
+	}, journal.Changes)
+
+	// journal of other height is not affected
+	journal, err = gm.GetBlockJournal(ctx, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(journal.Changes))
+}
+
 func TestAddConsumption(t *testing.T) {
 	ctx, gm := setupTest(t)
 
@@ -67,6 +67,11 @@ func ErrFailedToMarshalTime(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalTime, fmt.Sprintf("failed to marshal time: %s", err.Error()))
 }
 
+// ErrFailedToMarshalBlockJournal - error if marshal block journal failed
+func ErrFailedToMarshalBlockJournal(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalBlockJournal, fmt.Sprintf("failed to marshal block journal: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalTimeEventList - error if unmarshal time event list failed
 func ErrFailedToUnmarshalTimeEventList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalTimeEventList, fmt.Sprintf("failed to unmarshal time event list: %s", err.Error()))
@@ -101,3 +106,8 @@ func ErrFailedToUnmarshalTPS(err error) sdk.Error {
 func ErrFailedToUnmarshalTime(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalTime, fmt.Sprintf("failed to unmarshal time: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalBlockJournal - error if unmarshal block journal failed
+func ErrFailedToUnmarshalBlockJournal(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalBlockJournal, fmt.Sprintf("failed to unmarshal block journal: %s", err.Error()))
+}
@@ -77,6 +77,19 @@ func (c *ConsumptionMeta) ToIR() ConsumptionMetaIR {
 	}
 }
 
+// BalanceChange - a coin movement initiated by the blockchain itself instead of
+// a transaction. Username is empty if coin is minted into the inflation pools.
+type BalanceChange struct {
+	Username   types.AccountKey         `json:"username"`
+	Amount     types.Coin               `json:"amount"`
+	DetailType types.TransferDetailType `json:"detail_type"`
+}
+
+// BlockJournal - all balance changes initiated by the blockchain at a height
+type BlockJournal struct {
+	Changes []BalanceChange `json:"changes"`
+}
+
 // InitParamList - genesis parameters
 type InitParamList struct {
 	MaxTPS                       sdk.Dec `json:"max_tps"`
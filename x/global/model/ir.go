@@ -31,5 +31,6 @@ type GlobalMiscIR struct {
 type GlobalTablesIR struct {
 	GlobalTimeEventLists []GlobalTimeEventTimeRow `json:"global_time_event_lists"`
 	GlobalStakeStats     []GlobalStakeStatDayRow  `json:"global_stake_stats"`
+	GlobalBalanceChanges []GlobalBalanceChangeRow `json:"global_balance_changes"`
 	GlobalMisc           GlobalMiscIR             `json:"global_misc"`
 }
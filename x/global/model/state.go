@@ -4,6 +4,14 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// table names of global storage, used in streaming export and import.
+const (
+	GlobalTimeEventTable     = "global_time_event_lists"
+	GlobalStakeStatTable     = "global_stake_stats"
+	GlobalBalanceChangeTable = "global_balance_changes"
+	GlobalMiscTable          = "global_misc"
+)
+
 // GlobalTimeEventTimeRow - events, pk: UnixTime
 type GlobalTimeEventTimeRow struct {
 	UnixTime      int64               `json:"unix_time"`
@@ -16,6 +24,13 @@ type GlobalStakeStatDayRow struct {
 	StakeStat LinoStakeStat `json:"stake_stat"`
 }
 
+// GlobalBalanceChangeRow - balance change initiated by the blockchain, pk: (height, seq)
+type GlobalBalanceChangeRow struct {
+	Height int64         `json:"height"`
+	Seq    int64         `json:"seq"`
+	Change BalanceChange `json:"change"`
+}
+
 // GlobalMisc - a bunch of global variables with no pk, pk: none
 type GlobalMisc struct {
 	Meta            GlobalMeta      `json:"meta"`
@@ -40,6 +55,7 @@ func (g GlobalMisc) ToIR() GlobalMiscIR {
 type GlobalTables struct {
 	GlobalTimeEventLists []GlobalTimeEventTimeRow `json:"global_time_event_lists"`
 	GlobalStakeStats     []GlobalStakeStatDayRow  `json:"global_stake_stats"`
+	GlobalBalanceChanges []GlobalBalanceChangeRow `json:"global_balance_changes"`
 	GlobalMisc           GlobalMisc               `json:"global_misc"`
 }
 
@@ -48,6 +64,7 @@ func (g GlobalTables) ToIR() GlobalTablesIR {
 	return GlobalTablesIR{
 		GlobalTimeEventLists: g.GlobalTimeEventLists,
 		GlobalStakeStats:     g.GlobalStakeStats,
+		GlobalBalanceChanges: g.GlobalBalanceChanges,
 		GlobalMisc:           g.GlobalMisc.ToIR(),
 	}
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/binary"
 	"strconv"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +19,7 @@ var (
 	tpsSubStore             = []byte{0x04} // SubStore for tps
 	timeSubStore            = []byte{0x05} // SubStore for time
 	linoStakeStatSubStore   = []byte{0x06} // SubStore for lino power statistic
+	blockJournalSubStore    = []byte{0x07} // SubStore for block journal
 )
 
 // GlobalStorage - global storage
@@ -41,6 +43,8 @@ func NewGlobalStorage(key sdk.StoreKey) GlobalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "param/bandwidth", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "param/account", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "param/post", nil)
+	cdc.RegisterConcrete(param.InfraParam{}, "param/infra", nil)
+	cdc.RegisterConcrete(param.FeatureParam{}, "param/feature", nil)
 
 	wire.RegisterCrypto(cdc)
 	return GlobalStorage{
@@ -174,6 +178,46 @@ func (gs GlobalStorage) GetLinoStakeStat(ctx sdk.Context, day int64) (*LinoStake
 	return linoStakeStat, nil
 }
 
+// AddBalanceChange - append a balance change initiated by the blockchain to journal at given height
+func (gs GlobalStorage) AddBalanceChange(ctx sdk.Context, height int64, change *BalanceChange) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	seq := int64(0)
+	prefix := getBlockJournalPrefix(height)
+	itr := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if itr.Valid() {
+		seq = int64(binary.BigEndian.Uint64(itr.Key()[len(prefix):])) + 1
+	}
+	itr.Close()
+	return gs.SetBalanceChange(ctx, height, seq, change)
+}
+
+// SetBalanceChange - set balance change of journal at given height and @p seq
+func (gs GlobalStorage) SetBalanceChange(ctx sdk.Context, height, seq int64, change *BalanceChange) sdk.Error {
+	store := ctx.KVStore(gs.key)
+	changeBytes, err := gs.cdc.MarshalBinaryLengthPrefixed(*change)
+	if err != nil {
+		return ErrFailedToMarshalBlockJournal(err)
+	}
+	store.Set(getBalanceChangeKey(height, seq), changeBytes)
+	return nil
+}
+
+// GetBlockJournal - get balance changes initiated by the blockchain at given height, in order
+func (gs GlobalStorage) GetBlockJournal(ctx sdk.Context, height int64) (*BlockJournal, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	itr := sdk.KVStorePrefixIterator(store, getBlockJournalPrefix(height))
+	defer itr.Close()
+	journal := &BlockJournal{Changes: []BalanceChange{}}
+	for ; itr.Valid(); itr.Next() {
+		change := BalanceChange{}
+		if err := gs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &change); err != nil {
+			return nil, ErrFailedToUnmarshalBlockJournal(err)
+		}
+		journal.Changes = append(journal.Changes, change)
+	}
+	return journal, nil
+}
+
 // GetGlobalMeta - get global meta from KVStore
 func (gs GlobalStorage) GetGlobalMeta(ctx sdk.Context) (*GlobalMeta, sdk.Error) {
 	store := ctx.KVStore(gs.key)
@@ -302,52 +346,106 @@ func (gs GlobalStorage) SetGlobalTime(ctx sdk.Context, globalTime *GlobalTime) s
 // Export - export global tables.
 func (gs GlobalStorage) Export(ctx sdk.Context) *GlobalTables {
 	tables := &GlobalTables{}
+	gs.iterateTimeEventRows(ctx, func(row GlobalTimeEventTimeRow) {
+		tables.GlobalTimeEventLists = append(tables.GlobalTimeEventLists, row)
+	})
+	gs.iterateStakeStatRows(ctx, func(row GlobalStakeStatDayRow) {
+		tables.GlobalStakeStats = append(tables.GlobalStakeStats, row)
+	})
+	gs.iterateBalanceChangeRows(ctx, func(row GlobalBalanceChangeRow) {
+		tables.GlobalBalanceChanges = append(tables.GlobalBalanceChanges, row)
+	})
+	tables.GlobalMisc = gs.globalMisc(ctx)
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (gs GlobalStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	gs.iterateTimeEventRows(ctx, func(row GlobalTimeEventTimeRow) {
+		write(GlobalTimeEventTable, row)
+	})
+	gs.iterateStakeStatRows(ctx, func(row GlobalStakeStatDayRow) {
+		write(GlobalStakeStatTable, row)
+	})
+	gs.iterateBalanceChangeRows(ctx, func(row GlobalBalanceChangeRow) {
+		write(GlobalBalanceChangeTable, row)
+	})
+	write(GlobalMiscTable, gs.globalMisc(ctx).ToIR())
+}
+
+func (gs GlobalStorage) iterateTimeEventRows(ctx sdk.Context, process func(GlobalTimeEventTimeRow)) {
+	store := ctx.KVStore(gs.key)
+	itr := sdk.KVStorePrefixIterator(store, timeEventListSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		timestr := string(k[1:])
+		unixTime, err := strconv.ParseInt(timestr, 10, 64)
+		if err != nil {
+			panic("failed to parse int: " + err.Error())
+		}
+		eventlist, err := gs.GetTimeEventList(ctx, unixTime)
+		if err != nil {
+			panic("failed to read eventlist: " + err.Error())
+		}
+		process(GlobalTimeEventTimeRow{
+			UnixTime:      unixTime,
+			TimeEventList: *eventlist,
+		})
+	}
+}
+
+func (gs GlobalStorage) iterateStakeStatRows(ctx sdk.Context, process func(GlobalStakeStatDayRow)) {
 	store := ctx.KVStore(gs.key)
-	// export table.TimeEventLists
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, timeEventListSubStore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			timestr := string(k[1:])
-			unixTime, err := strconv.ParseInt(timestr, 10, 64)
-			if err != nil {
-				panic("failed to parse int: " + err.Error())
-			}
-			eventlist, err := gs.GetTimeEventList(ctx, unixTime)
-			if err != nil {
-				panic("failed to read eventlist: " + err.Error())
-			}
-			row := GlobalTimeEventTimeRow{
-				UnixTime:      unixTime,
-				TimeEventList: *eventlist,
-			}
-			tables.GlobalTimeEventLists = append(tables.GlobalTimeEventLists, row)
+	itr := sdk.KVStorePrefixIterator(store, linoStakeStatSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		daystr := string(k[1:])
+		day, err := strconv.ParseInt(daystr, 10, 64)
+		if err != nil {
+			panic("failed to parse int: " + err.Error())
 		}
-	}()
-	// export tables.StakeStats
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, linoStakeStatSubStore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			daystr := string(k[1:])
-			day, err := strconv.ParseInt(daystr, 10, 64)
-			if err != nil {
-				panic("failed to parse int: " + err.Error())
-			}
-			stats, err := gs.GetLinoStakeStat(ctx, day)
-			if err != nil {
-				panic("failed to read stake stat: " + err.Error())
-			}
-			row := GlobalStakeStatDayRow{
-				Day:       day,
-				StakeStat: *stats,
-			}
-			tables.GlobalStakeStats = append(tables.GlobalStakeStats, row)
+		stats, err := gs.GetLinoStakeStat(ctx, day)
+		if err != nil {
+			panic("failed to read stake stat: " + err.Error())
 		}
-	}()
-	// global miscs
+		process(GlobalStakeStatDayRow{
+			Day:       day,
+			StakeStat: *stats,
+		})
+	}
+}
+
+func (gs GlobalStorage) iterateBalanceChangeRows(ctx sdk.Context, process func(GlobalBalanceChangeRow)) {
+	store := ctx.KVStore(gs.key)
+	itr := sdk.KVStorePrefixIterator(store, blockJournalSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		// key is substore + height + separator + 8 bytes seq.
+		if len(k) < len(blockJournalSubStore)+len(types.KeySeparator)+8 {
+			panic("failed to split out height seq: " + string(k))
+		}
+		heightstr := string(k[len(blockJournalSubStore) : len(k)-8-len(types.KeySeparator)])
+		height, err := strconv.ParseInt(heightstr, 10, 64)
+		if err != nil {
+			panic("failed to parse int: " + err.Error())
+		}
+		seq := int64(binary.BigEndian.Uint64(k[len(k)-8:]))
+		change := BalanceChange{}
+		if err := gs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &change); err != nil {
+			panic("failed to read balance change: " + err.Error())
+		}
+		process(GlobalBalanceChangeRow{
+			Height: height,
+			Seq:    seq,
+			Change: change,
+		})
+	}
+}
+
+func (gs GlobalStorage) globalMisc(ctx sdk.Context) GlobalMisc {
 	meta, err := gs.GetGlobalMeta(ctx)
 	if err != nil {
 		panic("failed to get global meta")
@@ -368,15 +466,13 @@ func (gs GlobalStorage) Export(ctx sdk.Context) *GlobalTables {
 	if err != nil {
 		panic("failed to get global time")
 	}
-	misc := GlobalMisc{
+	return GlobalMisc{
 		Meta:            *meta,
 		InflationPool:   *pool,
 		ConsumptionMeta: *consumptionMeta,
 		TPS:             *tps,
 		Time:            *time,
 	}
-	tables.GlobalMisc = misc
-	return tables
 }
 
 // Import from tablesIR.
@@ -396,8 +492,50 @@ func (gs GlobalStorage) Import(ctx sdk.Context, tb *GlobalTablesIR) {
 		err := gs.SetLinoStakeStat(ctx, v.Day, &v.StakeStat)
 		check(err)
 	}
+	// import table.GlobalBalanceChanges
+	for _, v := range tb.GlobalBalanceChanges {
+		err := gs.SetBalanceChange(ctx, v.Height, v.Seq, &v.Change)
+		check(err)
+	}
 	// import table.Misc
-	misc := tb.GlobalMisc
+	gs.importGlobalMisc(ctx, tb.GlobalMisc)
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (gs GlobalStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[gs] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case GlobalTimeEventTable:
+		row := GlobalTimeEventTimeRow{}
+		check(read(&row))
+		check(gs.SetTimeEventList(ctx, row.UnixTime, &row.TimeEventList))
+	case GlobalStakeStatTable:
+		row := GlobalStakeStatDayRow{}
+		check(read(&row))
+		check(gs.SetLinoStakeStat(ctx, row.Day, &row.StakeStat))
+	case GlobalBalanceChangeTable:
+		row := GlobalBalanceChangeRow{}
+		check(read(&row))
+		check(gs.SetBalanceChange(ctx, row.Height, row.Seq, &row.Change))
+	case GlobalMiscTable:
+		row := GlobalMiscIR{}
+		check(read(&row))
+		gs.importGlobalMisc(ctx, row)
+	default:
+		panic("[gs] Failed to import: unknown table " + table)
+	}
+}
+
+func (gs GlobalStorage) importGlobalMisc(ctx sdk.Context, misc GlobalMiscIR) {
+	check := func(e error) {
+		if e != nil {
+			panic("[gs] Failed to import: " + e.Error())
+		}
+	}
 	err := gs.SetGlobalMeta(ctx, &misc.Meta)
 	check(err)
 
@@ -458,3 +596,13 @@ func GetTPSKey() []byte {
 func GetTimeKey() []byte {
 	return timeSubStore
 }
+
+func getBlockJournalPrefix(height int64) []byte {
+	return append(append(blockJournalSubStore, strconv.FormatInt(height, 10)...), types.KeySeparator...)
+}
+
+func getBalanceChangeKey(height, seq int64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, uint64(seq))
+	return append(getBlockJournalPrefix(height), seqBytes...)
+}
@@ -23,6 +23,7 @@ const (
 	QueryTPS             = "tps"
 	QueryLinoStakeStat   = "linoStakeStat"
 	QueryGlobalTime      = "globalTime"
+	QueryBlockJournal    = "blockJournal"
 )
 
 // creates a querier for global REST endpoints
@@ -43,6 +44,8 @@ func NewQuerier(gm GlobalManager) sdk.Querier {
 			return queryGlobalTime(ctx, cdc, path[1:], req, gm)
 		case QueryLinoStakeStat:
 			return queryLinoStakeStat(ctx, cdc, path[1:], req, gm)
+		case QueryBlockJournal:
+			return queryBlockJournal(ctx, cdc, path[1:], req, gm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown global query endpoint")
 		}
@@ -146,3 +149,22 @@ func queryLinoStakeStat(ctx sdk.Context, cdc *wire.Codec, path []string, req abc
 	}
 	return res, nil
 }
+
+func queryBlockJournal(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, gm GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	height, convertErr := strconv.ParseInt(path[0], 10, 64)
+	if convertErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	journal, err := gm.storage.GetBlockJournal(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(journal)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
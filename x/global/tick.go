@@ -19,16 +19,18 @@ func BeginBlocker(
 	return
 }
 
-// EndBlocker - related to upgrade1update3.
+// EndBlocker - related to FeatureRewardPoolAdjustment.
 func EndBlocker(
 	ctx sdk.Context, req abci.RequestEndBlock, gm *GlobalManager) (tags sdk.Tags) {
 	if err := gm.CommitEventCache(ctx); err != nil {
 		panic(err)
 	}
 
-	// one time execution for upgrade1update3, divided by 6 because it's the approximate right
-	// reward pool amount.
-	if ctx.BlockHeight() == types.BlockchainUpgrade1Update3Height {
+	// one time execution at activation of FeatureRewardPoolAdjustment, divided by 6 because
+	// it's the approximate right reward pool amount. Activation height of a feature can't be
+	// changed once reached, so the adjustment never runs twice.
+	if height, ok := gm.paramHolder.GetFeatureActivationHeight(
+		ctx, types.FeatureRewardPoolAdjustment); ok && ctx.BlockHeight() == height {
 		consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
 		if err != nil {
 			panic(err)
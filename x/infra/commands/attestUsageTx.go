@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	infra "github.com/lino-network/lino/x/infra"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttestUsageTxCmd - developer attests infra usage consumed from provider
+func AttestUsageTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-usage",
+		Short: "developer attest infra provider usage",
+		RunE:  sendAttestUsageTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer of this transaction")
+	cmd.Flags().String(client.FlagProvider, "", "infra provider of the usage")
+	cmd.Flags().String(client.FlagInfraType, "", "infra type of the usage, storage or cdn")
+	cmd.Flags().String(client.FlagUsage, "", "usage consumed by developer")
+	return cmd
+}
+
+// send attest usage transaction to the blockchain
+func sendAttestUsageTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		developer := viper.GetString(client.FlagDeveloper)
+		provider := viper.GetString(client.FlagProvider)
+		infraType, err := parseInfraType(viper.GetString(client.FlagInfraType))
+		if err != nil {
+			return err
+		}
+		usage, err := strconv.ParseInt(viper.GetString(client.FlagUsage), 10, 64)
+		if err != nil {
+			return err
+		}
+		msg := infra.NewAttestUsageMsg(developer, provider, infraType, usage)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
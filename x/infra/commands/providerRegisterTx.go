@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	infra "github.com/lino-network/lino/x/infra"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderRegisterTxCmd - register to be infra provider
+func ProviderRegisterTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-register",
+		Short: "infra provider register",
+		RunE:  sendProviderRegisterTx(cdc),
+	}
+	cmd.Flags().String(client.FlagProvider, "", "infra provider name of this transaction")
+	cmd.Flags().String(client.FlagDeposit, "", "deposit of the registration")
+	cmd.Flags().String(client.FlagInfraType, "", "infra type provided, storage, cdn or both")
+	cmd.Flags().String(client.FlagWebsite, "", "website of the infra provider")
+	cmd.Flags().String(client.FlagDescription, "", "description of the infra provider")
+	return cmd
+}
+
+// send provider register transaction to the blockchain
+func sendProviderRegisterTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagProvider)
+		infraType, err := parseInfraType(viper.GetString(client.FlagInfraType))
+		if err != nil {
+			return err
+		}
+		msg := infra.NewProviderRegisterMsg(
+			username, types.LNO(viper.GetString(client.FlagDeposit)), infraType,
+			viper.GetString(client.FlagWebsite), viper.GetString(client.FlagDescription))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// parse infra type from command line
+func parseInfraType(infraTypeStr string) (types.InfraType, error) {
+	switch infraTypeStr {
+	case "storage":
+		return types.StorageInfra, nil
+	case "cdn":
+		return types.CDNInfra, nil
+	case "both":
+		return types.StorageAndCDNInfra, nil
+	default:
+		return 0, errors.New("infra type must be storage, cdn or both")
+	}
+}
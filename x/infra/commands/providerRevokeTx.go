@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	infra "github.com/lino-network/lino/x/infra"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderRevokeTxCmd - revoke infra provider
+func ProviderRevokeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-revoke",
+		Short: "infra provider revoke",
+		RunE:  sendProviderRevokeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagProvider, "", "infra provider name of this transaction")
+	return cmd
+}
+
+// send provider revoke transaction to the blockchain
+func sendProviderRevokeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagProvider)
+		msg := infra.NewProviderRevokeMsg(username)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
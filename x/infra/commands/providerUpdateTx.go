@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	infra "github.com/lino-network/lino/x/infra"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderUpdateTxCmd - update infra provider info
+func ProviderUpdateTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-update",
+		Short: "infra provider update",
+		RunE:  sendProviderUpdateTx(cdc),
+	}
+	cmd.Flags().String(client.FlagProvider, "", "infra provider name of this transaction")
+	cmd.Flags().String(client.FlagInfraType, "", "infra type provided, storage, cdn or both")
+	cmd.Flags().String(client.FlagWebsite, "", "website of the infra provider")
+	cmd.Flags().String(client.FlagDescription, "", "description of the infra provider")
+	return cmd
+}
+
+// send provider update transaction to the blockchain
+func sendProviderUpdateTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagProvider)
+		infraType, err := parseInfraType(viper.GetString(client.FlagInfraType))
+		if err != nil {
+			return err
+		}
+		msg := infra.NewProviderUpdateMsg(
+			username, infraType, viper.GetString(client.FlagWebsite),
+			viper.GetString(client.FlagDescription))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// ErrAccountNotFound - error if account doesn't exist
+func ErrAccountNotFound() sdk.Error {
+	return types.NewError(types.CodeAccountNotFound, fmt.Sprintf("account not found"))
+}
+
 // ErrProviderNotFound - error if infra provider is not found
 func ErrProviderNotFound() sdk.Error {
 	return types.NewError(types.CodeInfraProviderNotFound, fmt.Sprintf("provider is not found"))
@@ -26,3 +31,50 @@ func ErrInvalidUsage() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeInfraQueryFailed, fmt.Sprintf("query infra store failed"))
 }
+
+// ErrInfraProviderAlreadyExist - error if infra provider is already registered
+func ErrInfraProviderAlreadyExist(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInfraProviderAlreadyExist, fmt.Sprintf("infra provider %v already exist", username))
+}
+
+// ErrInsufficientInfraDeposit - error if infra provider deposit is insufficient
+func ErrInsufficientInfraDeposit() sdk.Error {
+	return types.NewError(types.CodeInsufficientInfraDeposit, fmt.Sprintf("infra provider deposit not enough"))
+}
+
+// ErrInvalidWebsite - error if website length invalid
+func ErrInvalidWebsite() sdk.Error {
+	return types.NewError(types.CodeInvalidInfraWebsite, fmt.Sprintf("invalid website"))
+}
+
+// ErrInvalidDescription - error if description length invalid
+func ErrInvalidDescription() sdk.Error {
+	return types.NewError(types.CodeInvalidInfraDescription, fmt.Sprintf("invalid description"))
+}
+
+// ErrDeveloperNotFound - error if developer attesting usage doesn't exist
+func ErrDeveloperNotFound() sdk.Error {
+	return types.NewError(types.CodeInfraDeveloperNotFound, fmt.Sprintf("developer not found"))
+}
+
+// ErrCannotAttestOwnUsage - error if infra provider attests usage of itself
+func ErrCannotAttestOwnUsage() sdk.Error {
+	return types.NewError(types.CodeCannotAttestOwnUsage, fmt.Sprintf("can't attest usage of own infra"))
+}
+
+// ErrInvalidInfraType - error if infra type is invalid
+func ErrInvalidInfraType() sdk.Error {
+	return types.NewError(types.CodeInvalidInfraType, fmt.Sprintf("invalid infra type"))
+}
+
+// ErrInfraTypeNotProvided - error if infra provider doesn't offer given infra type
+func ErrInfraTypeNotProvided(provider types.AccountKey, infraType types.InfraType) sdk.Error {
+	return types.NewError(
+		types.CodeInfraTypeNotProvided,
+		fmt.Sprintf("infra provider %v doesn't provide infra type %v", provider, infraType))
+}
+
+// ErrUsageExceedsLimit - error if attested usage exceeds the usage limit of a period
+func ErrUsageExceedsLimit() sdk.Error {
+	return types.NewError(types.CodeInfraUsageExceedsLimit, fmt.Sprintf("attested usage exceeds limit"))
+}
@@ -5,14 +5,28 @@ import (
 	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	global "github.com/lino-network/lino/x/global"
 )
 
 // NewHandler - Handle all "infra" type messages.
-func NewHandler(im InfraManager) sdk.Handler {
+func NewHandler(
+	im InfraManager, am acc.AccountManager, gm *global.GlobalManager, dm dev.DeveloperManager) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case ProviderReportMsg:
 			return handleProviderReportMsg(ctx, im, msg)
+		case ProviderRegisterMsg:
+			return handleProviderRegisterMsg(ctx, im, am, msg)
+		case ProviderUpdateMsg:
+			return handleProviderUpdateMsg(ctx, im, msg)
+		case ProviderRevokeMsg:
+			return handleProviderRevokeMsg(ctx, im, am, gm, msg)
+		case AttestUsageMsg:
+			return handleAttestUsageMsg(ctx, im, dm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized infra msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -28,5 +42,119 @@ func handleProviderReportMsg(ctx sdk.Context, im InfraManager, msg ProviderRepor
 	if err := im.ReportUsage(ctx, msg.Username, msg.Usage); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Username)}
+}
+
+func handleAttestUsageMsg(
+	ctx sdk.Context, im InfraManager, dm dev.DeveloperManager, msg AttestUsageMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Developer) {
+		return ErrDeveloperNotFound().Result()
+	}
+
+	if !im.DoesInfraProviderExist(ctx, msg.Provider) {
+		return ErrProviderNotFound().Result()
+	}
+
+	if msg.Developer == msg.Provider {
+		return ErrCannotAttestOwnUsage().Result()
+	}
+
+	if err := im.AttestUsage(ctx, msg.Developer, msg.Provider, msg.Type, msg.Usage); err != nil {
+		return err.Result()
+	}
+	tags := types.NewAccountTags(msg.Developer).AppendTag(types.TagReceiver, string(msg.Provider))
+	return sdk.Result{Tags: tags}
+}
+
+func handleProviderRegisterMsg(
+	ctx sdk.Context, im InfraManager, am acc.AccountManager, msg ProviderRegisterMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound().Result()
+	}
+
+	if im.DoesInfraProviderExist(ctx, msg.Username) {
+		return ErrInfraProviderAlreadyExist(msg.Username).Result()
+	}
+
+	deposit, err := types.LinoToCoin(msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+
+	param, err := im.paramHolder.GetInfraParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	// check infra provider minimum deposit requirement
+	if !deposit.IsGTE(param.InfraMinDeposit) {
+		return ErrInsufficientInfraDeposit().Result()
+	}
+
+	// withdraw money from infra provider's bank
+	if err := am.MinusSavingCoin(
+		ctx, msg.Username, deposit, "", "", types.InfraDeposit); err != nil {
+		return err.Result()
+	}
+	if err := im.RegisterInfraProvider(
+		ctx, msg.Username, deposit, msg.Type, msg.Website, msg.Description); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Username, "", deposit, types.InfraDeposit)}
+}
+
+func handleProviderUpdateMsg(ctx sdk.Context, im InfraManager, msg ProviderUpdateMsg) sdk.Result {
+	if !im.DoesInfraProviderExist(ctx, msg.Username) {
+		return ErrProviderNotFound().Result()
+	}
+
+	if err := im.UpdateInfraProvider(
+		ctx, msg.Username, msg.Type, msg.Website, msg.Description); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: types.NewAccountTags(msg.Username)}
+}
+
+func handleProviderRevokeMsg(
+	ctx sdk.Context, im InfraManager, am acc.AccountManager,
+	gm *global.GlobalManager, msg ProviderRevokeMsg) sdk.Result {
+	if !im.DoesInfraProviderExist(ctx, msg.Username) {
+		return ErrProviderNotFound().Result()
+	}
+
+	coin, err := im.RevokeInfraProvider(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+
+	param, err := im.paramHolder.GetInfraParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !coin.IsZero() {
+		if err := returnCoinTo(
+			ctx, msg.Username, gm, am, param.InfraCoinReturnTimes, param.InfraCoinReturnIntervalSec, coin); err != nil {
+			return err.Result()
+		}
+	}
+	return sdk.Result{Tags: types.NewTransferTags("", msg.Username, coin, types.InfraReturnCoin)}
+}
+
+func returnCoinTo(
+	ctx sdk.Context, name types.AccountKey, gm *global.GlobalManager,
+	am acc.AccountManager, times int64, interval int64, coin types.Coin) sdk.Error {
+	if err := am.AddFrozenMoney(
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
+		return err
+	}
+
+	events, err := acc.CreateCoinReturnEvents(ctx, name, times, interval, coin, types.InfraReturnCoin)
+	if err != nil {
+		return err
+	}
+
+	if err := gm.RegisterCoinReturnEvent(ctx, events, times, interval); err != nil {
+		return err
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package infra
 
 import (
+	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,13 +10,13 @@ import (
 )
 
 func TestReportBasic(t *testing.T) {
-	ctx, im := setupTest(t, 0)
-	handler := NewHandler(im)
+	ctx, im, am, gm, dm := setupTestWithAccount(t, 0)
+	handler := NewHandler(im, am, &gm, dm)
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
 	usage := int64(100)
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
 
 	// infra provider does not exist
 	msg1 := NewProviderReportMsg("qwdqwdqw", usage)
@@ -24,9 +25,184 @@ func TestReportBasic(t *testing.T) {
 
 	msg2 := NewProviderReportMsg("user1", usage)
 	res2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, res2)
+	assert.Equal(t, sdk.Result{Tags: types.NewAccountTags(user1)}, res2)
 
 	provider, _ := im.storage.GetInfraProvider(ctx, user1)
 	assert.Equal(t, usage, provider.Usage)
 
 }
+
+func TestRegisterBasic(t *testing.T) {
+	ctx, im, am, gm, dm := setupTestWithAccount(t, 0)
+	handler := NewHandler(im, am, &gm, dm)
+	im.InitGenesis(ctx)
+
+	infraParam, err := im.paramHolder.GetInfraParam(ctx)
+	assert.Nil(t, err)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(t, ctx, am, "user1", infraParam.InfraMinDeposit.Plus(minBalance))
+	minDeposit, _ := infraParam.InfraMinDeposit.ToInt64()
+	deposit := strconv.FormatInt(minDeposit/types.Decimals, 10)
+	insufficientDeposit := strconv.FormatInt(minDeposit/types.Decimals-1, 10)
+	depositRes := sdk.Result{
+		Tags: types.NewTransferTags(user1, "", infraParam.InfraMinDeposit, types.InfraDeposit)}
+
+	testCases := []struct {
+		testName     string
+		msg          ProviderRegisterMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "account doesn't exist",
+			msg:          NewProviderRegisterMsg("invalid", deposit, types.StorageInfra, "", ""),
+			expectResult: ErrAccountNotFound().Result(),
+		},
+		{
+			testName: "insufficient deposit",
+			msg: NewProviderRegisterMsg(
+				"user1", insufficientDeposit, types.StorageInfra, "", ""),
+			expectResult: ErrInsufficientInfraDeposit().Result(),
+		},
+		{
+			testName: "normal register",
+			msg: NewProviderRegisterMsg(
+				"user1", deposit, types.StorageInfra, "https://cdn.lino.network", "cdn"),
+			expectResult: depositRes,
+		},
+		{
+			testName:     "register twice",
+			msg:          NewProviderRegisterMsg("user1", deposit, types.StorageInfra, "", ""),
+			expectResult: ErrInfraProviderAlreadyExist(user1).Result(),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// check deposit is withdrawn from saving
+	saving, err := am.GetSavingFromBank(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, minBalance, saving)
+	provider, err := im.storage.GetInfraProvider(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, infraParam.InfraMinDeposit, provider.Deposit)
+	assert.Equal(t, "https://cdn.lino.network", provider.Website)
+	lst, err := im.GetInfraProviderList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user1}, lst.AllInfraProviders)
+}
+
+func TestUpdateAndRevokeBasic(t *testing.T) {
+	ctx, im, am, gm, dm := setupTestWithAccount(t, 0)
+	handler := NewHandler(im, am, &gm, dm)
+	im.InitGenesis(ctx)
+
+	infraParam, err := im.paramHolder.GetInfraParam(ctx)
+	assert.Nil(t, err)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(t, ctx, am, "user1", infraParam.InfraMinDeposit.Plus(minBalance))
+	user2 := createTestAccount(t, ctx, am, "user2", minBalance)
+	minDeposit, _ := infraParam.InfraMinDeposit.ToInt64()
+	res := handler(ctx, NewProviderRegisterMsg(
+		"user1", strconv.FormatInt(minDeposit/types.Decimals, 10), types.StorageInfra, "", ""))
+	assert.True(t, res.IsOK())
+
+	// update
+	res = handler(ctx, NewProviderUpdateMsg(
+		"user1", types.CDNInfra, "https://cdn.lino.network", "cdn"))
+	assert.Equal(t, sdk.Result{Tags: types.NewAccountTags(user1)}, res)
+	provider, err := im.storage.GetInfraProvider(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.CDNInfra, provider.Type)
+	assert.Equal(t, "https://cdn.lino.network", provider.Website)
+	assert.Equal(t, "cdn", provider.Description)
+	res = handler(ctx, NewProviderUpdateMsg("user2", types.CDNInfra, "", ""))
+	assert.Equal(t, ErrProviderNotFound().Result(), res)
+
+	// revoke
+	res = handler(ctx, NewProviderRevokeMsg("user2"))
+	assert.Equal(t, ErrProviderNotFound().Result(), res)
+	res = handler(ctx, NewProviderRevokeMsg("user1"))
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", user1, infraParam.InfraMinDeposit, types.InfraReturnCoin)}, res)
+	assert.False(t, im.DoesInfraProviderExist(ctx, user1))
+	lst, err := im.GetInfraProviderList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(lst.AllInfraProviders))
+
+	// deposit is returned by coin return events, not immediately
+	saving, err := am.GetSavingFromBank(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, minBalance, saving)
+	frozenMoney, err := am.GetFrozenMoneyList(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(frozenMoney))
+	assert.Equal(t, infraParam.InfraMinDeposit, frozenMoney[0].Amount)
+	assert.Equal(t, infraParam.InfraCoinReturnTimes, frozenMoney[0].Times)
+
+	// revoked provider can register again
+	assert.Nil(t, am.AddSavingCoin(
+		ctx, user2, infraParam.InfraMinDeposit, "", "", types.TransferIn))
+	res = handler(ctx, NewProviderRegisterMsg(
+		"user2", strconv.FormatInt(minDeposit/types.Decimals, 10), types.StorageInfra, "", ""))
+	assert.True(t, res.IsOK())
+}
+
+func TestAttestUsageBasic(t *testing.T) {
+	ctx, im, am, gm, dm := setupTestWithAccount(t, 0)
+	handler := NewHandler(im, am, &gm, dm)
+	im.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
+	app1 := types.AccountKey("app1")
+	developerParam, err := im.paramHolder.GetDeveloperParam(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, dm.RegisterDeveloper(ctx, app1, developerParam.DeveloperMinDeposit, "", "", ""))
+	// infra provider which is also a developer
+	assert.Nil(t, dm.RegisterDeveloper(ctx, user1, developerParam.DeveloperMinDeposit, "", "", ""))
+
+	testCases := []struct {
+		testName     string
+		msg          AttestUsageMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "developer doesn't exist",
+			msg:          NewAttestUsageMsg("app2", "user1", types.StorageInfra, 100),
+			expectResult: ErrDeveloperNotFound().Result(),
+		},
+		{
+			testName:     "infra provider doesn't exist",
+			msg:          NewAttestUsageMsg("app1", "user2", types.StorageInfra, 100),
+			expectResult: ErrProviderNotFound().Result(),
+		},
+		{
+			testName:     "attest own usage",
+			msg:          NewAttestUsageMsg("user1", "user1", types.StorageInfra, 100),
+			expectResult: ErrCannotAttestOwnUsage().Result(),
+		},
+		{
+			testName: "attest usage",
+			msg:      NewAttestUsageMsg("app1", "user1", types.StorageInfra, 100),
+			expectResult: sdk.Result{
+				Tags: types.NewAccountTags(app1).AppendTag(types.TagReceiver, string(user1))},
+		},
+	}
+	for _, tc := range testCases {
+		res := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, res) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.expectResult)
+		}
+	}
+
+	provider, err := im.storage.GetInfraProvider(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), provider.AttestedUsage)
+	usage, err := im.storage.GetInfraUsage(ctx, user1, app1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), usage.Usage)
+}
@@ -1,6 +1,8 @@
 package infra
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
@@ -34,10 +36,16 @@ func (im InfraManager) DoesInfraProviderExist(ctx sdk.Context, username types.Ac
 	return im.storage.DoesInfraProviderExist(ctx, username)
 }
 
-// RegisterInfraProvider - register infra provider on KVStore
-func (im InfraManager) RegisterInfraProvider(ctx sdk.Context, username types.AccountKey) sdk.Error {
+// RegisterInfraProvider - register infra provider with deposit on KVStore
+func (im InfraManager) RegisterInfraProvider(
+	ctx sdk.Context, username types.AccountKey, deposit types.Coin,
+	infraType types.InfraType, website, description string) sdk.Error {
 	provider := &model.InfraProvider{
-		Username: username,
+		Username:    username,
+		Deposit:     deposit,
+		Type:        infraType,
+		Website:     website,
+		Description: description,
 	}
 	if err := im.storage.SetInfraProvider(ctx, username, provider); err != nil {
 		return err
@@ -48,6 +56,42 @@ func (im InfraManager) RegisterInfraProvider(ctx sdk.Context, username types.Acc
 	return nil
 }
 
+// UpdateInfraProvider - update infra provider type, website and description
+func (im InfraManager) UpdateInfraProvider(
+	ctx sdk.Context, username types.AccountKey, infraType types.InfraType,
+	website, description string) sdk.Error {
+	provider, err := im.storage.GetInfraProvider(ctx, username)
+	if err != nil {
+		return err
+	}
+	provider.Type = infraType
+	provider.Website = website
+	provider.Description = description
+	if err := im.storage.SetInfraProvider(ctx, username, provider); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RevokeInfraProvider - remove infra provider from KVStore and return its deposit
+func (im InfraManager) RevokeInfraProvider(
+	ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
+	provider, err := im.storage.GetInfraProvider(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if err := im.RemoveFromProviderList(ctx, username); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	im.storage.DeleteInfraProvider(ctx, username)
+	im.storage.DeleteProviderUsages(ctx, username)
+	// providers registered before deposit was introduced have no deposit.
+	if provider.Deposit == (types.Coin{}) {
+		return types.NewCoinFromInt64(0), nil
+	}
+	return provider.Deposit, nil
+}
+
 // AddToInfraProviderList - add infra provider to list
 func (im InfraManager) AddToInfraProviderList(ctx sdk.Context, username types.AccountKey) sdk.Error {
 	lst, err := im.storage.GetInfraProviderList(ctx)
@@ -84,7 +128,7 @@ func (im InfraManager) RemoveFromProviderList(ctx sdk.Context, username types.Ac
 	return nil
 }
 
-// ReportUsage - infra provider report usage and get reward
+// ReportUsage - infra provider report usage, self reported usage doesn't affect inflation
 func (im *InfraManager) ReportUsage(ctx sdk.Context, username types.AccountKey, usage int64) sdk.Error {
 	provider, err := im.storage.GetInfraProvider(ctx, username)
 	if err != nil {
@@ -97,29 +141,130 @@ func (im *InfraManager) ReportUsage(ctx sdk.Context, username types.AccountKey,
 	return nil
 }
 
-// GetUsageWeight - get the usage percentage of given infra provider
-func (im *InfraManager) GetUsageWeight(ctx sdk.Context, username types.AccountKey) (sdk.Dec, sdk.Error) {
+// AttestUsage - app attests usage of given infra type it consumed from infra provider
+func (im *InfraManager) AttestUsage(
+	ctx sdk.Context, app, provider types.AccountKey, infraType types.InfraType, usage int64) sdk.Error {
+	if infraType != types.StorageInfra && infraType != types.CDNInfra {
+		return ErrInvalidInfraType()
+	}
+	infraProvider, err := im.storage.GetInfraProvider(ctx, provider)
+	if err != nil {
+		return err
+	}
+	if !infraProvider.Provides(infraType) {
+		return ErrInfraTypeNotProvided(provider, infraType)
+	}
+	infraUsage, err := im.storage.GetInfraUsage(ctx, provider, app)
+	if err != nil {
+		infraUsage = &model.InfraUsage{
+			Provider: provider,
+			App:      app,
+		}
+	}
+	if usage <= 0 || usage > types.MaximumInfraUsagePerAttestation {
+		return ErrInvalidUsage()
+	}
+	// usage of an app is capped per period, total of a provider must not overflow
+	if infraType == types.StorageInfra {
+		if infraUsage.StorageUsage > types.MaximumInfraUsagePerPeriod-usage ||
+			infraProvider.AttestedStorageUsage > math.MaxInt64-usage {
+			return ErrUsageExceedsLimit()
+		}
+		infraUsage.StorageUsage += usage
+		infraProvider.AttestedStorageUsage += usage
+	} else {
+		if infraUsage.CDNUsage > types.MaximumInfraUsagePerPeriod-usage ||
+			infraProvider.AttestedCDNUsage > math.MaxInt64-usage {
+			return ErrUsageExceedsLimit()
+		}
+		infraUsage.CDNUsage += usage
+		infraProvider.AttestedCDNUsage += usage
+	}
+	if err := im.storage.SetInfraUsage(ctx, infraUsage); err != nil {
+		return err
+	}
+	if err := im.storage.SetInfraProvider(ctx, provider, infraProvider); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetProviderUsages - get usage of given infra provider attested by each app
+func (im *InfraManager) GetProviderUsages(
+	ctx sdk.Context, provider types.AccountKey) ([]model.InfraUsage, sdk.Error) {
+	return im.storage.GetProviderUsages(ctx, provider)
+}
+
+// GetAppUsages - get usage attested by given app for each infra provider
+func (im *InfraManager) GetAppUsages(
+	ctx sdk.Context, app types.AccountKey) ([]model.InfraUsage, sdk.Error) {
+	return im.storage.GetAppUsages(ctx, app)
+}
+
+// GetInfraProvidersByType - get infra providers which offer given infra type
+func (im *InfraManager) GetInfraProvidersByType(
+	ctx sdk.Context, infraType types.InfraType) ([]types.AccountKey, sdk.Error) {
 	lst, err := im.storage.GetInfraProviderList(ctx)
 	if err != nil {
-		return sdk.NewDec(0), err
+		return nil, err
 	}
 
-	totalUsage := int64(0)
-	myUsage := int64(0)
+	providers := []types.AccountKey{}
 	for _, providerName := range lst.AllInfraProviders {
 		curProvider, err := im.storage.GetInfraProvider(ctx, providerName)
 		if err != nil {
-			return sdk.NewDec(0), err
+			return nil, err
 		}
-		totalUsage += curProvider.Usage
-		if curProvider.Username == username {
-			myUsage = curProvider.Usage
+		if curProvider.Provides(infraType) {
+			providers = append(providers, providerName)
 		}
 	}
-	if totalUsage == int64(0) {
-		return types.NewDecFromRat(1, int64(len(lst.AllInfraProviders))), nil
+	return providers, nil
+}
+
+// GetTotalAttestedUsage - get the attested usage of given infra type summed over all infra providers
+func (im *InfraManager) GetTotalAttestedUsage(
+	ctx sdk.Context, infraType types.InfraType) (sdk.Int, sdk.Error) {
+	providers, err := im.GetInfraProvidersByType(ctx, infraType)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	totalUsage := sdk.ZeroInt()
+	for _, providerName := range providers {
+		curProvider, err := im.storage.GetInfraProvider(ctx, providerName)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		totalUsage = totalUsage.Add(sdk.NewInt(curProvider.AttestedUsage(infraType)))
 	}
-	return types.NewDecFromRat(myUsage, totalUsage), nil
+	return totalUsage, nil
+}
+
+// GetUsageWeight - get the attested usage percentage of given infra provider
+// among all infra providers offering given infra type, zero if nothing is attested
+func (im *InfraManager) GetUsageWeight(
+	ctx sdk.Context, username types.AccountKey, infraType types.InfraType) (sdk.Dec, sdk.Error) {
+	providers, err := im.GetInfraProvidersByType(ctx, infraType)
+	if err != nil {
+		return sdk.NewDec(0), err
+	}
+	if types.FindAccountInList(username, providers) == -1 {
+		return sdk.NewDec(0), nil
+	}
+
+	totalUsage, err := im.GetTotalAttestedUsage(ctx, infraType)
+	if err != nil {
+		return sdk.NewDec(0), err
+	}
+	if totalUsage.IsZero() {
+		return sdk.NewDec(0), nil
+	}
+	myProvider, err := im.storage.GetInfraProvider(ctx, username)
+	if err != nil {
+		return sdk.NewDec(0), err
+	}
+	myUsage := sdk.NewInt(myProvider.AttestedUsage(infraType))
+	return sdk.NewDecFromInt(myUsage).Quo(sdk.NewDecFromInt(totalUsage)), nil
 }
 
 // GetInfraProviderList - get the infra provider list
@@ -127,7 +272,7 @@ func (im *InfraManager) GetInfraProviderList(ctx sdk.Context) (*model.InfraProvi
 	return im.storage.GetInfraProviderList(ctx)
 }
 
-// ClearUsage - clear all infra provider report usage
+// ClearUsage - clear all infra provider reported and attested usage
 func (im *InfraManager) ClearUsage(ctx sdk.Context) sdk.Error {
 	lst, err := im.storage.GetInfraProviderList(ctx)
 	if err != nil {
@@ -140,9 +285,12 @@ func (im *InfraManager) ClearUsage(ctx sdk.Context) sdk.Error {
 			return err
 		}
 		curProvider.Usage = 0
+		curProvider.AttestedStorageUsage = 0
+		curProvider.AttestedCDNUsage = 0
 		if err := im.storage.SetInfraProvider(ctx, providerName, curProvider); err != nil {
 			return err
 		}
+		im.storage.DeleteProviderUsages(ctx, providerName)
 	}
 	return nil
 }
@@ -156,3 +304,13 @@ func (im *InfraManager) Export(ctx sdk.Context) *model.InfraTables {
 func (im *InfraManager) Import(ctx sdk.Context, tb *model.InfraTablesIR) {
 	im.storage.Import(ctx, tb)
 }
+
+// ExportRows - stream state of infra row by row.
+func (im *InfraManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	im.storage.ExportRows(ctx, write)
+}
+
+// ImportRow - import state of infra row by row.
+func (im *InfraManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	im.storage.ImportRow(ctx, table, read)
+}
@@ -1,10 +1,12 @@
 package infra
 
 import (
+	"math"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/infra/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,7 +15,7 @@ func TestRegister(t *testing.T) {
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
 
 	_, err := im.storage.GetInfraProvider(ctx, user1)
 	assert.Nil(t, err)
@@ -25,7 +27,7 @@ func TestInfraProviderList(t *testing.T) {
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
 
 	addErr := im.AddToInfraProviderList(ctx, "user1")
 	assert.Nil(t, addErr)
@@ -47,10 +49,10 @@ func TestReportUsage(t *testing.T) {
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
 
 	user2 := types.AccountKey("user2")
-	im.RegisterInfraProvider(ctx, user2)
+	im.RegisterInfraProvider(ctx, user2, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
 
 	im.AddToInfraProviderList(ctx, "user1")
 	im.AddToInfraProviderList(ctx, "user2")
@@ -81,20 +83,131 @@ func TestReportUsage(t *testing.T) {
 		},
 	}
 	for testName, tc := range testCases {
-		im.ReportUsage(ctx, "user1", tc.user1Usage)
-		im.ReportUsage(ctx, "user2", tc.user2Usage)
-
-		w1, _ := im.GetUsageWeight(ctx, "user1")
+		im.AttestUsage(ctx, "app1", "user1", types.StorageInfra, tc.user1Usage)
+		im.AttestUsage(ctx, "app1", "user2", types.StorageInfra, tc.user2Usage)
+		// self reported usage doesn't affect usage weight
+		im.ReportUsage(ctx, "user1", 1000000)
+		// CDN usage doesn't affect storage usage weight
+		im.AttestUsage(ctx, "app1", "user2", types.CDNInfra, 1000000)
+
+		w1, _ := im.GetUsageWeight(ctx, "user1", types.StorageInfra)
 		if !tc.expectUser1UsageWeight.Equal(w1) {
 			t.Errorf("%s: diff user1 usage weight, got %v, want %v", testName, w1, tc.expectUser1UsageWeight)
 			return
 		}
 
-		w2, _ := im.GetUsageWeight(ctx, "user2")
+		w2, _ := im.GetUsageWeight(ctx, "user2", types.StorageInfra)
 		if !tc.expectUser2UsageWeight.Equal(w2) {
 			t.Errorf("%s: diff user2 usage weight, got %v, want %v", testName, w2, tc.expectUser2UsageWeight)
 			return
 		}
 		im.ClearUsage(ctx)
+		usages, _ := im.GetProviderUsages(ctx, "user1")
+		if len(usages) != 0 {
+			t.Errorf("%s: usage not cleared, got %v", testName, usages)
+			return
+		}
 	}
 }
+
+func TestAttestUsage(t *testing.T) {
+	ctx, im := setupTest(t, 0)
+	im.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
+	user2 := types.AccountKey("user2")
+	im.RegisterInfraProvider(ctx, user2, types.NewCoinFromInt64(0), types.StorageInfra, "", "")
+
+	app1 := types.AccountKey("app1")
+	app2 := types.AccountKey("app2")
+	assert.Nil(t, im.AttestUsage(ctx, app1, user1, types.StorageInfra, 10))
+	assert.Nil(t, im.AttestUsage(ctx, app1, user1, types.StorageInfra, 5))
+	assert.Nil(t, im.AttestUsage(ctx, app1, user1, types.CDNInfra, 7))
+	assert.Nil(t, im.AttestUsage(ctx, app2, user1, types.CDNInfra, 20))
+	assert.Nil(t, im.AttestUsage(ctx, app1, user2, types.StorageInfra, 30))
+	assert.NotNil(t, im.AttestUsage(ctx, app1, "user3", types.StorageInfra, 30))
+	assert.Equal(
+		t, ErrInfraTypeNotProvided(user2, types.CDNInfra),
+		im.AttestUsage(ctx, app1, user2, types.CDNInfra, 30))
+	assert.Equal(
+		t, ErrInvalidInfraType(), im.AttestUsage(ctx, app1, user1, types.StorageAndCDNInfra, 30))
+
+	provider, err := im.storage.GetInfraProvider(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), provider.AttestedStorageUsage)
+	assert.Equal(t, int64(27), provider.AttestedCDNUsage)
+	assert.Equal(t, int64(0), provider.Usage)
+
+	usages, err := im.GetProviderUsages(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.InfraUsage{
+		{Provider: user1, App: app1, StorageUsage: 15, CDNUsage: 7},
+		{Provider: user1, App: app2, CDNUsage: 20},
+	}, usages)
+
+	usages, err = im.GetAppUsages(ctx, app1)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.InfraUsage{
+		{Provider: user1, App: app1, StorageUsage: 15, CDNUsage: 7},
+		{Provider: user2, App: app1, StorageUsage: 30},
+	}, usages)
+
+	// weight is calculated among providers of the same infra type
+	storageProviders, err := im.GetInfraProvidersByType(ctx, types.StorageInfra)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user1, user2}, storageProviders)
+	cdnProviders, err := im.GetInfraProvidersByType(ctx, types.CDNInfra)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user1}, cdnProviders)
+	w, err := im.GetUsageWeight(ctx, user1, types.StorageInfra)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewDecFromRat(1, 3), w)
+	w, err = im.GetUsageWeight(ctx, user1, types.CDNInfra)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewDecFromRat(1, 1), w)
+	w, err = im.GetUsageWeight(ctx, user2, types.CDNInfra)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.NewDec(0), w)
+}
+
+func TestAttestUsageLimit(t *testing.T) {
+	ctx, im := setupTest(t, 0)
+	im.InitGenesis(ctx)
+
+	user1 := types.AccountKey("user1")
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
+	user2 := types.AccountKey("user2")
+	im.RegisterInfraProvider(ctx, user2, types.NewCoinFromInt64(0), types.StorageAndCDNInfra, "", "")
+	app1 := types.AccountKey("app1")
+
+	// nothing attested, no provider has weight
+	w, err := im.GetUsageWeight(ctx, user1, types.StorageInfra)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.NewDec(0), w)
+
+	assert.Equal(t, ErrInvalidUsage(), im.AttestUsage(ctx, app1, user1, types.StorageInfra, 0))
+	assert.Equal(t, ErrInvalidUsage(), im.AttestUsage(
+		ctx, app1, user1, types.StorageInfra, types.MaximumInfraUsagePerAttestation+1))
+
+	// usage of an app is capped per period
+	times := types.MaximumInfraUsagePerPeriod / types.MaximumInfraUsagePerAttestation
+	for i := int64(0); i < times; i++ {
+		assert.Nil(t, im.AttestUsage(ctx, app1, user1, types.StorageInfra, types.MaximumInfraUsagePerAttestation))
+	}
+	assert.Equal(t, ErrUsageExceedsLimit(), im.AttestUsage(ctx, app1, user1, types.StorageInfra, 1))
+	assert.Nil(t, im.AttestUsage(ctx, app1, user1, types.CDNInfra, 1))
+	assert.Nil(t, im.AttestUsage(ctx, app1, user2, types.StorageInfra, 1))
+
+	// total of a provider can't overflow
+	provider, err := im.storage.GetInfraProvider(ctx, user2)
+	assert.Nil(t, err)
+	provider.AttestedStorageUsage = math.MaxInt64 - 1
+	assert.Nil(t, im.storage.SetInfraProvider(ctx, user2, provider))
+	assert.Equal(t, ErrUsageExceedsLimit(), im.AttestUsage(ctx, "app2", user2, types.StorageInfra, 2))
+
+	// weight is summed without overflow
+	w, err = im.GetUsageWeight(ctx, user2, types.StorageInfra)
+	assert.Nil(t, err)
+	assert.True(t, w.GT(sdk.NewDec(0)) && w.LT(sdk.NewDec(1)))
+}
@@ -36,3 +36,18 @@ func ErrFailedToUnmarshalInfraProvider(err error) sdk.Error {
 func ErrFailedToUnmarshalInfraProviderList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalInfraProviderList, fmt.Sprintf("failed to unmarshal infra provider list: %s", err.Error()))
 }
+
+// ErrInfraUsageNotFound - error if infra usage is not found
+func ErrInfraUsageNotFound() sdk.Error {
+	return types.NewError(types.CodeInfraUsageNotFound, fmt.Sprintf("infra usage is not found"))
+}
+
+// ErrFailedToMarshalInfraUsage - error if marshal infra usage failed
+func ErrFailedToMarshalInfraUsage(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalInfraUsage, fmt.Sprintf("failed to marshal infra usage: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalInfraUsage - error if unmarshal infra usage failed
+func ErrFailedToUnmarshalInfraUsage(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalInfraUsage, fmt.Sprintf("failed to unmarshal infra usage: %s", err.Error()))
+}
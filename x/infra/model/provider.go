@@ -5,12 +5,48 @@ import (
 )
 
 // InfraProvider - infra provider of blockchain
+// Usage is self reported by provider, AttestedStorageUsage and AttestedCDNUsage are the sum
+// of usage attested by apps and are the only ones used to weight infra inflation.
 type InfraProvider struct {
-	Username types.AccountKey `json:"username"`
-	Usage    int64            `json:"usage"`
+	Username             types.AccountKey `json:"username"`
+	Usage                int64            `json:"usage"`
+	Deposit              types.Coin       `json:"deposit"`
+	Website              string           `json:"web_site"`
+	Description          string           `json:"description"`
+	Type                 types.InfraType  `json:"type"`
+	AttestedStorageUsage int64            `json:"attested_storage_usage"`
+	AttestedCDNUsage     int64            `json:"attested_cdn_usage"`
+}
+
+// Provides - check if infra provider offers given infra type,
+// provider registered before infra type was introduced offers both.
+func (p InfraProvider) Provides(infraType types.InfraType) bool {
+	if p.Type == 0 {
+		return true
+	}
+	return p.Type&infraType == infraType
+}
+
+// AttestedUsage - get attested usage of given infra type
+func (p InfraProvider) AttestedUsage(infraType types.InfraType) int64 {
+	switch infraType {
+	case types.StorageInfra:
+		return p.AttestedStorageUsage
+	case types.CDNInfra:
+		return p.AttestedCDNUsage
+	}
+	return 0
 }
 
 // InfraProviderList - infra provider list of blockchain
 type InfraProviderList struct {
 	AllInfraProviders []types.AccountKey `json:"all_infra_providers"`
 }
+
+// InfraUsage - usage of infra provider attested by an app
+type InfraUsage struct {
+	Provider     types.AccountKey `json:"provider"`
+	App          types.AccountKey `json:"app"`
+	StorageUsage int64            `json:"storage_usage"`
+	CDNUsage     int64            `json:"cdn_usage"`
+}
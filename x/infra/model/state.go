@@ -4,6 +4,13 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// table names of infra storage, used in streaming export and import.
+const (
+	InfraProviderTable     = "infra_providers"
+	InfraProviderListTable = "infra_provider_list"
+	InfraUsageTable        = "infra_usages"
+)
+
 // InfraProviderRow - infra provider, pk: app
 type InfraProviderRow struct {
 	App      types.AccountKey `json:"app"`
@@ -15,10 +22,16 @@ type InfraProviderListRow struct {
 	List InfraProviderList `json:"list"`
 }
 
+// InfraUsageRow - usage attested by app, pk: (provider, app)
+type InfraUsageRow struct {
+	Usage InfraUsage `json:"usage"`
+}
+
 // InfraTables infra storage state
 type InfraTables struct {
 	InfraProviders    []InfraProviderRow
 	InfraProviderList InfraProviderListRow
+	InfraUsages       []InfraUsageRow
 }
 
 // ToIR - same
@@ -9,6 +9,7 @@ import (
 var (
 	infraProviderSubstore     = []byte{0x00}
 	infraProviderListSubstore = []byte{0x01}
+	infraUsageSubstore        = []byte{0x02}
 )
 
 // InfraProviderStorage - infra provider storage
@@ -68,6 +69,88 @@ func (is InfraProviderStorage) SetInfraProvider(
 	return nil
 }
 
+// DeleteInfraProvider - delete infra provider from KVStore
+func (is InfraProviderStorage) DeleteInfraProvider(ctx sdk.Context, accKey types.AccountKey) {
+	store := ctx.KVStore(is.key)
+	store.Delete(GetInfraProviderKey(accKey))
+}
+
+// GetInfraUsage - get usage of provider attested by app from KVStore
+func (is InfraProviderStorage) GetInfraUsage(
+	ctx sdk.Context, provider, app types.AccountKey) (*InfraUsage, sdk.Error) {
+	store := ctx.KVStore(is.key)
+	usageByte := store.Get(GetInfraUsageKey(provider, app))
+	if usageByte == nil {
+		return nil, ErrInfraUsageNotFound()
+	}
+	usage := new(InfraUsage)
+	if err := is.cdc.UnmarshalBinaryLengthPrefixed(usageByte, usage); err != nil {
+		return nil, ErrFailedToUnmarshalInfraUsage(err)
+	}
+	return usage, nil
+}
+
+// SetInfraUsage - set usage of provider attested by app to KVStore
+func (is InfraProviderStorage) SetInfraUsage(ctx sdk.Context, usage *InfraUsage) sdk.Error {
+	store := ctx.KVStore(is.key)
+	usageByte, err := is.cdc.MarshalBinaryLengthPrefixed(*usage)
+	if err != nil {
+		return ErrFailedToMarshalInfraUsage(err)
+	}
+	store.Set(GetInfraUsageKey(usage.Provider, usage.App), usageByte)
+	return nil
+}
+
+// GetProviderUsages - get all app attested usage of provider from KVStore
+func (is InfraProviderStorage) GetProviderUsages(
+	ctx sdk.Context, provider types.AccountKey) ([]InfraUsage, sdk.Error) {
+	usages := []InfraUsage{}
+	store := ctx.KVStore(is.key)
+	itr := sdk.KVStorePrefixIterator(store, GetInfraUsagePrefix(provider))
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		usage := new(InfraUsage)
+		if err := is.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), usage); err != nil {
+			return nil, ErrFailedToUnmarshalInfraUsage(err)
+		}
+		usages = append(usages, *usage)
+	}
+	return usages, nil
+}
+
+// GetAppUsages - get all usage attested by app from KVStore
+func (is InfraProviderStorage) GetAppUsages(
+	ctx sdk.Context, app types.AccountKey) ([]InfraUsage, sdk.Error) {
+	usages := []InfraUsage{}
+	store := ctx.KVStore(is.key)
+	itr := sdk.KVStorePrefixIterator(store, infraUsageSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		usage := new(InfraUsage)
+		if err := is.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), usage); err != nil {
+			return nil, ErrFailedToUnmarshalInfraUsage(err)
+		}
+		if usage.App == app {
+			usages = append(usages, *usage)
+		}
+	}
+	return usages, nil
+}
+
+// DeleteProviderUsages - delete all app attested usage of provider from KVStore
+func (is InfraProviderStorage) DeleteProviderUsages(ctx sdk.Context, provider types.AccountKey) {
+	store := ctx.KVStore(is.key)
+	itr := sdk.KVStorePrefixIterator(store, GetInfraUsagePrefix(provider))
+	keys := [][]byte{}
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetInfraProviderList - get infra provider list from KVStore
 func (is InfraProviderStorage) GetInfraProviderList(ctx sdk.Context) (*InfraProviderList, sdk.Error) {
 	store := ctx.KVStore(is.key)
@@ -96,34 +179,68 @@ func (is InfraProviderStorage) SetInfraProviderList(ctx sdk.Context, lst *InfraP
 // Export - infra state
 func (is InfraProviderStorage) Export(ctx sdk.Context) *InfraTables {
 	tables := &InfraTables{}
+	is.iterateInfraProviderRows(ctx, func(row InfraProviderRow) {
+		tables.InfraProviders = append(tables.InfraProviders, row)
+	})
+	tables.InfraProviderList = is.infraProviderListRow(ctx)
+	is.iterateInfraUsageRows(ctx, func(row InfraUsageRow) {
+		tables.InfraUsages = append(tables.InfraUsages, row)
+	})
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (is InfraProviderStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	is.iterateInfraProviderRows(ctx, func(row InfraProviderRow) {
+		write(InfraProviderTable, row)
+	})
+	write(InfraProviderListTable, is.infraProviderListRow(ctx))
+	is.iterateInfraUsageRows(ctx, func(row InfraUsageRow) {
+		write(InfraUsageTable, row)
+	})
+}
+
+func (is InfraProviderStorage) iterateInfraProviderRows(ctx sdk.Context, process func(InfraProviderRow)) {
+	store := ctx.KVStore(is.key)
+	itr := sdk.KVStorePrefixIterator(store, infraProviderSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		username := types.AccountKey(k[1:])
+		provider, err := is.GetInfraProvider(ctx, username)
+		if err != nil {
+			panic("failed to read infra provider: " + err.Error())
+		}
+		process(InfraProviderRow{
+			App:      username,
+			Provider: *provider,
+		})
+	}
+}
+
+func (is InfraProviderStorage) iterateInfraUsageRows(ctx sdk.Context, process func(InfraUsageRow)) {
 	store := ctx.KVStore(is.key)
-	// export table.providers
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, infraProviderSubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			username := types.AccountKey(k[1:])
-			provider, err := is.GetInfraProvider(ctx, username)
-			if err != nil {
-				panic("failed to read developer: " + err.Error())
-			}
-			row := InfraProviderRow{
-				App:      username,
-				Provider: *provider,
-			}
-			tables.InfraProviders = append(tables.InfraProviders, row)
+	itr := sdk.KVStorePrefixIterator(store, infraUsageSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		usage := new(InfraUsage)
+		if err := is.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), usage); err != nil {
+			panic("failed to read infra usage: " + err.Error())
 		}
-	}()
-	// export table.DeveloperList
+		process(InfraUsageRow{
+			Usage: *usage,
+		})
+	}
+}
+
+func (is InfraProviderStorage) infraProviderListRow(ctx sdk.Context) InfraProviderListRow {
 	list, err := is.GetInfraProviderList(ctx)
 	if err != nil {
-		panic("failed to get developer list: " + err.Error())
+		panic("failed to get infra provider list: " + err.Error())
 	}
-	tables.InfraProviderList = InfraProviderListRow{
+	return InfraProviderListRow{
 		List: *list,
 	}
-	return tables
 }
 
 // Import from tablesIR.
@@ -141,6 +258,35 @@ func (is InfraProviderStorage) Import(ctx sdk.Context, tb *InfraTablesIR) {
 	// import ProviderList
 	err := is.SetInfraProviderList(ctx, &tb.InfraProviderList.List)
 	check(err)
+	// import table.Usages
+	for _, v := range tb.InfraUsages {
+		check(is.SetInfraUsage(ctx, &v.Usage))
+	}
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (is InfraProviderStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[is] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case InfraProviderTable:
+		row := InfraProviderRow{}
+		check(read(&row))
+		check(is.SetInfraProvider(ctx, row.App, &row.Provider))
+	case InfraProviderListTable:
+		row := InfraProviderListRow{}
+		check(read(&row))
+		check(is.SetInfraProviderList(ctx, &row.List))
+	case InfraUsageTable:
+		row := InfraUsageRow{}
+		check(read(&row))
+		check(is.SetInfraUsage(ctx, &row.Usage))
+	default:
+		panic("[is] Failed to import: unknown table " + table)
+	}
 }
 
 // GetInfraProviderKey - get infra provider key in infra provider substore
@@ -152,3 +298,13 @@ func GetInfraProviderKey(accKey types.AccountKey) []byte {
 func GetInfraProviderListKey() []byte {
 	return infraProviderListSubstore
 }
+
+// GetInfraUsagePrefix - get prefix of all app attested usage of provider in infra usage substore
+func GetInfraUsagePrefix(provider types.AccountKey) []byte {
+	return append(append(infraUsageSubstore, provider...), types.KeySeparator...)
+}
+
+// GetInfraUsageKey - get usage key of provider attested by app in infra usage substore
+func GetInfraUsageKey(provider, app types.AccountKey) []byte {
+	return append(GetInfraUsagePrefix(provider), app...)
+}
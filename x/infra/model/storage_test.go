@@ -19,8 +19,12 @@ var (
 
 func TestInfraProvider(t *testing.T) {
 	provider := InfraProvider{
-		Username: "user1",
-		Usage:    int64(1000),
+		Username:             "user1",
+		Usage:                int64(1000),
+		Deposit:              types.NewCoinFromInt64(1),
+		Type:                 types.StorageAndCDNInfra,
+		AttestedStorageUsage: int64(100),
+		AttestedCDNUsage:     int64(50),
 	}
 
 	runTest(t, func(env TestEnv) {
@@ -50,6 +54,41 @@ func TestInfraProviderList(t *testing.T) {
 
 }
 
+func TestInfraUsage(t *testing.T) {
+	u1 := InfraUsage{Provider: "provider1", App: "app1", StorageUsage: 10}
+	u2 := InfraUsage{Provider: "provider1", App: "app2", CDNUsage: 20}
+	u3 := InfraUsage{Provider: "provider2", App: "app1", StorageUsage: 30, CDNUsage: 5}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.is.GetInfraUsage(env.ctx, "provider1", "app1")
+		assert.Equal(t, ErrInfraUsageNotFound(), err)
+		for _, u := range []InfraUsage{u1, u2, u3} {
+			usage := u
+			assert.Nil(t, env.is.SetInfraUsage(env.ctx, &usage))
+		}
+
+		resultPtr, err := env.is.GetInfraUsage(env.ctx, "provider1", "app1")
+		assert.Nil(t, err)
+		assert.Equal(t, u1, *resultPtr)
+
+		usages, err := env.is.GetProviderUsages(env.ctx, "provider1")
+		assert.Nil(t, err)
+		assert.Equal(t, []InfraUsage{u1, u2}, usages)
+
+		usages, err = env.is.GetAppUsages(env.ctx, "app1")
+		assert.Nil(t, err)
+		assert.Equal(t, []InfraUsage{u1, u3}, usages)
+
+		env.is.DeleteProviderUsages(env.ctx, "provider1")
+		usages, err = env.is.GetProviderUsages(env.ctx, "provider1")
+		assert.Nil(t, err)
+		assert.Equal(t, []InfraUsage{}, usages)
+		usages, err = env.is.GetProviderUsages(env.ctx, "provider2")
+		assert.Nil(t, err)
+		assert.Equal(t, []InfraUsage{u3}, usages)
+	})
+}
+
 //
 // Test Environment setup
 //
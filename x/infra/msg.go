@@ -3,12 +3,17 @@ package infra
 // nolint
 import (
 	"fmt"
+	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
 )
 
 var _ types.Msg = ProviderReportMsg{}
+var _ types.Msg = ProviderRegisterMsg{}
+var _ types.Msg = ProviderUpdateMsg{}
+var _ types.Msg = ProviderRevokeMsg{}
+var _ types.Msg = AttestUsageMsg{}
 
 // ProviderReportMsg - infra provider report infra usage to blockchain
 type ProviderReportMsg struct {
@@ -16,6 +21,36 @@ type ProviderReportMsg struct {
 	Usage    int64            `json:"usage"`
 }
 
+// ProviderRegisterMsg - register infra provider with deposit on blockchain
+type ProviderRegisterMsg struct {
+	Username    types.AccountKey `json:"username"`
+	Deposit     types.LNO        `json:"deposit"`
+	Type        types.InfraType  `json:"type"`
+	Website     string           `json:"website"`
+	Description string           `json:"description"`
+}
+
+// ProviderUpdateMsg - update infra provider info on blockchain
+type ProviderUpdateMsg struct {
+	Username    types.AccountKey `json:"username"`
+	Type        types.InfraType  `json:"type"`
+	Website     string           `json:"website"`
+	Description string           `json:"description"`
+}
+
+// ProviderRevokeMsg - revoke infra provider and return deposit
+type ProviderRevokeMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
+// AttestUsageMsg - developer attests infra usage consumed from provider
+type AttestUsageMsg struct {
+	Developer types.AccountKey `json:"developer"`
+	Provider  types.AccountKey `json:"provider"`
+	Type      types.InfraType  `json:"type"`
+	Usage     int64            `json:"usage"`
+}
+
 //----------------------------------------
 // ReportMsg Msg Implementations
 // NewProviderReportMsg - new ProviderReportMsg
@@ -73,3 +108,273 @@ func (msg ProviderReportMsg) GetSigners() []sdk.AccAddress {
 func (msg ProviderReportMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+//----------------------------------------
+// ProviderRegisterMsg Msg Implementations
+
+// NewProviderRegisterMsg - new ProviderRegisterMsg
+func NewProviderRegisterMsg(
+	provider string, deposit types.LNO, infraType types.InfraType,
+	website, description string) ProviderRegisterMsg {
+	return ProviderRegisterMsg{
+		Username:    types.AccountKey(provider),
+		Deposit:     deposit,
+		Type:        infraType,
+		Website:     website,
+		Description: description,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ProviderRegisterMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ProviderRegisterMsg) Type() string { return "ProviderRegisterMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ProviderRegisterMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if _, err := types.LinoToCoin(msg.Deposit); err != nil {
+		return err
+	}
+
+	if msg.Type < types.StorageInfra || msg.Type > types.StorageAndCDNInfra {
+		return ErrInvalidInfraType()
+	}
+
+	if len(msg.Website) > types.MaximumLengthOfInfraWebsite {
+		return ErrInvalidWebsite()
+	}
+
+	if utf8.RuneCountInString(msg.Description) > types.MaximumLengthOfInfraDescription {
+		return ErrInvalidDescription()
+	}
+	return nil
+}
+
+func (msg ProviderRegisterMsg) String() string {
+	return fmt.Sprintf(
+		"ProviderRegisterMsg{Username:%v, Deposit:%v, Type:%v}", msg.Username, msg.Deposit, msg.Type)
+}
+
+// GetPermission - implements types.Msg
+func (msg ProviderRegisterMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ProviderRegisterMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ProviderRegisterMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ProviderRegisterMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ProviderUpdateMsg Msg Implementations
+
+// NewProviderUpdateMsg - new ProviderUpdateMsg
+func NewProviderUpdateMsg(
+	provider string, infraType types.InfraType, website, description string) ProviderUpdateMsg {
+	return ProviderUpdateMsg{
+		Username:    types.AccountKey(provider),
+		Type:        infraType,
+		Website:     website,
+		Description: description,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ProviderUpdateMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ProviderUpdateMsg) Type() string { return "ProviderUpdateMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ProviderUpdateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if msg.Type < types.StorageInfra || msg.Type > types.StorageAndCDNInfra {
+		return ErrInvalidInfraType()
+	}
+
+	if len(msg.Website) > types.MaximumLengthOfInfraWebsite {
+		return ErrInvalidWebsite()
+	}
+
+	if utf8.RuneCountInString(msg.Description) > types.MaximumLengthOfInfraDescription {
+		return ErrInvalidDescription()
+	}
+	return nil
+}
+
+func (msg ProviderUpdateMsg) String() string {
+	return fmt.Sprintf(
+		"ProviderUpdateMsg{Username:%v, Type:%v, Website:%v, Description:%v}",
+		msg.Username, msg.Type, msg.Website, msg.Description)
+}
+
+// GetPermission - implements types.Msg
+func (msg ProviderUpdateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ProviderUpdateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ProviderUpdateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ProviderUpdateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ProviderRevokeMsg Msg Implementations
+
+// NewProviderRevokeMsg - new ProviderRevokeMsg
+func NewProviderRevokeMsg(provider string) ProviderRevokeMsg {
+	return ProviderRevokeMsg{
+		Username: types.AccountKey(provider),
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ProviderRevokeMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ProviderRevokeMsg) Type() string { return "ProviderRevokeMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ProviderRevokeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ProviderRevokeMsg) String() string {
+	return fmt.Sprintf("ProviderRevokeMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implements types.Msg
+func (msg ProviderRevokeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ProviderRevokeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ProviderRevokeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ProviderRevokeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// AttestUsageMsg Msg Implementations
+
+// NewAttestUsageMsg - new AttestUsageMsg
+func NewAttestUsageMsg(
+	developer, provider string, infraType types.InfraType, usage int64) AttestUsageMsg {
+	return AttestUsageMsg{
+		Developer: types.AccountKey(developer),
+		Provider:  types.AccountKey(provider),
+		Type:      infraType,
+		Usage:     usage,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg AttestUsageMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg AttestUsageMsg) Type() string { return "AttestUsageMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg AttestUsageMsg) ValidateBasic() sdk.Error {
+	if len(msg.Developer) < types.MinimumUsernameLength ||
+		len(msg.Developer) > types.MaximumUsernameLength ||
+		len(msg.Provider) < types.MinimumUsernameLength ||
+		len(msg.Provider) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	// usage is attested for storage or CDN separately
+	if msg.Type != types.StorageInfra && msg.Type != types.CDNInfra {
+		return ErrInvalidInfraType()
+	}
+
+	if msg.Usage <= 0 || msg.Usage > types.MaximumInfraUsagePerAttestation {
+		return ErrInvalidUsage()
+	}
+	return nil
+}
+
+func (msg AttestUsageMsg) String() string {
+	return fmt.Sprintf(
+		"AttestUsageMsg{Developer:%v, Provider:%v, Type:%v, Usage:%v}",
+		msg.Developer, msg.Provider, msg.Type, msg.Usage)
+}
+
+// GetPermission - implements types.Msg
+func (msg AttestUsageMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg AttestUsageMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg AttestUsageMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Developer)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg AttestUsageMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -40,6 +40,176 @@ func TestProviderReportMsg(t *testing.T) {
 	}
 }
 
+func TestProviderRegisterMsg(t *testing.T) {
+	testCases := []struct {
+		testName            string
+		providerRegisterMsg ProviderRegisterMsg
+		expectError         sdk.Error
+	}{
+		{
+			testName: "normal case",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "1", types.StorageInfra, "website", "description"),
+			expectError: nil,
+		},
+		{
+			testName: "register both storage and CDN",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "1", types.StorageAndCDNInfra, "website", "description"),
+			expectError: nil,
+		},
+		{
+			testName: "invalid infra type",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "1", types.InfraType(0), "website", "description"),
+			expectError: ErrInvalidInfraType(),
+		},
+		{
+			testName: "unknown infra type",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "1", types.InfraType(4), "website", "description"),
+			expectError: ErrInvalidInfraType(),
+		},
+		{
+			testName: "invalid username",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"", "1", types.StorageInfra, "website", "description"),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid deposit",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "-1", types.StorageInfra, "website", "description"),
+			expectError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "website is too long",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "1", types.StorageInfra,
+				string(make([]byte, types.MaximumLengthOfInfraWebsite+1)), "description"),
+			expectError: ErrInvalidWebsite(),
+		},
+		{
+			testName: "description is too long",
+			providerRegisterMsg: NewProviderRegisterMsg(
+				"user1", "1", types.StorageInfra,
+				"website", string(make([]byte, types.MaximumLengthOfInfraDescription+1))),
+			expectError: ErrInvalidDescription(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.providerRegisterMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestProviderUpdateAndRevokeMsg(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		msg         sdk.Msg
+		expectError sdk.Error
+	}{
+		{
+			testName:    "normal update",
+			msg:         NewProviderUpdateMsg("user1", types.CDNInfra, "website", "description"),
+			expectError: nil,
+		},
+		{
+			testName:    "update with invalid username",
+			msg:         NewProviderUpdateMsg("", types.CDNInfra, "website", "description"),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "update with invalid infra type",
+			msg:         NewProviderUpdateMsg("user1", types.InfraType(0), "website", "description"),
+			expectError: ErrInvalidInfraType(),
+		},
+		{
+			testName: "update with too long website",
+			msg: NewProviderUpdateMsg(
+				"user1", types.CDNInfra,
+				string(make([]byte, types.MaximumLengthOfInfraWebsite+1)), "description"),
+			expectError: ErrInvalidWebsite(),
+		},
+		{
+			testName:    "normal revoke",
+			msg:         NewProviderRevokeMsg("user1"),
+			expectError: nil,
+		},
+		{
+			testName:    "revoke with invalid username",
+			msg:         NewProviderRevokeMsg(""),
+			expectError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestAttestUsageMsg(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		msg         AttestUsageMsg
+		expectError sdk.Error
+	}{
+		{
+			testName:    "normal case",
+			msg:         NewAttestUsageMsg("app1", "user1", types.StorageInfra, 100),
+			expectError: nil,
+		},
+		{
+			testName:    "invalid developer",
+			msg:         NewAttestUsageMsg("", "user1", types.StorageInfra, 100),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "invalid provider",
+			msg:         NewAttestUsageMsg("app1", "", types.StorageInfra, 100),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "attest usage of both storage and CDN",
+			msg:         NewAttestUsageMsg("app1", "user1", types.StorageAndCDNInfra, 100),
+			expectError: ErrInvalidInfraType(),
+		},
+		{
+			testName:    "zero usage",
+			msg:         NewAttestUsageMsg("app1", "user1", types.CDNInfra, 0),
+			expectError: ErrInvalidUsage(),
+		},
+		{
+			testName:    "negative usage",
+			msg:         NewAttestUsageMsg("app1", "user1", types.StorageInfra, -1),
+			expectError: ErrInvalidUsage(),
+		},
+		{
+			testName:    "maximum usage",
+			msg:         NewAttestUsageMsg("app1", "user1", types.StorageInfra, types.MaximumInfraUsagePerAttestation),
+			expectError: nil,
+		},
+		{
+			testName:    "usage exceeds maximum of one attestation",
+			msg:         NewAttestUsageMsg("app1", "user1", types.StorageInfra, types.MaximumInfraUsagePerAttestation+1),
+			expectError: ErrInvalidUsage(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := map[string]struct {
 		msg              types.Msg
@@ -49,6 +219,22 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewProviderReportMsg("test", 1),
 			expectPermission: types.TransactionPermission,
 		},
+		"provider register msg": {
+			msg:              NewProviderRegisterMsg("test", "1", types.StorageInfra, "", ""),
+			expectPermission: types.TransactionPermission,
+		},
+		"provider update msg": {
+			msg:              NewProviderUpdateMsg("test", types.CDNInfra, "", ""),
+			expectPermission: types.TransactionPermission,
+		},
+		"provider revoke msg": {
+			msg:              NewProviderRevokeMsg("test"),
+			expectPermission: types.TransactionPermission,
+		},
+		"attest usage msg": {
+			msg:              NewAttestUsageMsg("app", "test", types.StorageInfra, 1),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -83,6 +269,10 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewProviderReportMsg("test", 1),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		"attest usage msg": {
+			msg:           NewAttestUsageMsg("app", "test", types.StorageInfra, 1),
+			expectSigners: []types.AccountKey{"app"},
+		},
 	}
 
 	for testName, tc := range testCases {
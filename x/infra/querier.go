@@ -19,6 +19,8 @@ const (
 
 	QueryInfraProvider = "infra"
 	QueryInfraList     = "infraList"
+	QueryInfraUsage    = "usage"
+	QueryAppInfraUsage = "appUsage"
 )
 
 // creates a querier for infra REST endpoints
@@ -31,6 +33,10 @@ func NewQuerier(im InfraManager) sdk.Querier {
 			return queryInfraProvider(ctx, cdc, path[1:], req, im)
 		case QueryInfraList:
 			return queryInfraList(ctx, cdc, path[1:], req, im)
+		case QueryInfraUsage:
+			return queryInfraUsage(ctx, cdc, path[1:], req, im)
+		case QueryAppInfraUsage:
+			return queryAppInfraUsage(ctx, cdc, path[1:], req, im)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown infra query endpoint")
 		}
@@ -63,3 +69,33 @@ func queryInfraList(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.Re
 	}
 	return res, nil
 }
+
+func queryInfraUsage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, im InfraManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	usages, err := im.GetProviderUsages(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(usages)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryAppInfraUsage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, im InfraManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	usages, err := im.GetAppUsages(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(usages)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -6,22 +6,46 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	global "github.com/lino-network/lino/x/global"
+	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
 var (
-	testInfraKVStoreKey = sdk.NewKVStoreKey("infra")
-	testParamKVStoreKey = sdk.NewKVStoreKey("param")
+	testInfraKVStoreKey   = sdk.NewKVStoreKey("infra")
+	testParamKVStoreKey   = sdk.NewKVStoreKey("param")
+	testAccountKVStoreKey = sdk.NewKVStoreKey("account")
+	testGlobalKVStoreKey  = sdk.NewKVStoreKey("global")
+	testDevKVStoreKey     = sdk.NewKVStoreKey("developer")
 )
 
 func setupTest(t *testing.T, height int64) (sdk.Context, InfraManager) {
+	ctx, im, _, _, _ := setupTestWithAccount(t, height)
+	return ctx, im
+}
+
+func setupTestWithAccount(t *testing.T, height int64) (
+	sdk.Context, InfraManager, acc.AccountManager, global.GlobalManager, dev.DeveloperManager) {
 	ctx := getContext(height)
 	ph := param.NewParamHolder(testParamKVStoreKey)
 	ph.InitParam(ctx)
 	im := NewInfraManager(testInfraKVStoreKey, ph)
-	return ctx, im
+	am := acc.NewAccountManager(testAccountKVStoreKey, ph)
+	gm := global.NewGlobalManager(testGlobalKVStoreKey, ph)
+	dm := dev.NewDeveloperManager(testDevKVStoreKey, ph)
+	dm.InitGenesis(ctx)
+	cdc := gm.WireCodec()
+	err := gm.InitGlobalManager(ctx, types.NewCoinFromInt64(10000*types.Decimals))
+	assert.Nil(t, err)
+	cdc.RegisterInterface((*types.Event)(nil), nil)
+	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "event/return", nil)
+	return ctx, im, am, gm, dm
 }
 
 func getContext(height int64) sdk.Context {
@@ -29,7 +53,20 @@ func getContext(height int64) sdk.Context {
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(testInfraKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testAccountKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testGlobalKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testDevKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
 	return sdk.NewContext(ms, abci.Header{Height: height}, false, log.NewNopLogger())
 }
+
+// helper function to create an account for testing purpose
+func createTestAccount(
+	t *testing.T, ctx sdk.Context, am acc.AccountManager, username string, initCoin types.Coin) types.AccountKey {
+	err := am.CreateAccount(ctx, "referrer", types.AccountKey(username),
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(), initCoin)
+	assert.Nil(t, err)
+	return types.AccountKey(username)
+}
@@ -7,6 +7,10 @@ import (
 // RegisterWire - register concrete types on wire codec
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ProviderReportMsg{}, "lino/providerReport", nil)
+	cdc.RegisterConcrete(ProviderRegisterMsg{}, "lino/providerRegister", nil)
+	cdc.RegisterConcrete(ProviderUpdateMsg{}, "lino/providerUpdate", nil)
+	cdc.RegisterConcrete(ProviderRevokeMsg{}, "lino/providerRevoke", nil)
+	cdc.RegisterConcrete(AttestUsageMsg{}, "lino/attestUsage", nil)
 }
 
 var msgCdc = wire.New()
@@ -37,7 +37,7 @@ func sendDonateTx(cdc *wire.Codec) client.CommandTxCallback {
 		postID := viper.GetString(client.FlagPostID)
 		msg := post.NewDonateMsg(
 			username, types.LNO(viper.GetString(client.FlagAmount)),
-			author, postID, "", viper.GetString(client.FlagMemo))
+			author, postID, viper.GetString(client.FlagApp), viper.GetString(client.FlagMemo))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
@@ -29,6 +30,7 @@ func PostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagSourceAuthor, "", "source post author name")
 	cmd.Flags().String(client.FlagSourcePostID, "", "source post id")
 	cmd.Flags().String(client.FlagRedistributionSplitRate, "0", "redistribution split rate")
+	cmd.Flags().String(client.FlagTags, "", "comma separated tags of the post")
 	return cmd
 }
 
@@ -47,6 +49,8 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 			SourceAuthor:            types.AccountKey(viper.GetString(client.FlagSourceAuthor)),
 			SourcePostID:            viper.GetString(client.FlagSourcePostID),
 			RedistributionSplitRate: viper.GetString(client.FlagRedistributionSplitRate),
+			Tags:                    parseTags(viper.GetString(client.FlagTags)),
+			FromApp:                 types.AccountKey(viper.GetString(client.FlagApp)),
 		}
 
 		// build and sign the transaction, then broadcast to Tendermint
@@ -60,3 +64,15 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 		return nil
 	}
 }
+
+// parseTags - split comma separated tags, empty input means no tag
+func parseTags(tags string) []string {
+	if tags == "" {
+		return nil
+	}
+	res := []string{}
+	for _, tag := range strings.Split(tags, ",") {
+		res = append(res, strings.TrimSpace(tag))
+	}
+	return res
+}
@@ -1,12 +1,16 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	post "github.com/lino-network/lino/x/post"
 	"github.com/lino-network/lino/x/post/model"
 )
 
@@ -65,44 +69,221 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// GetPostsCmd returns a query post that will display the
-// info and meta of the post at a given author and postID
+// GetPostsCmd returns a query post that will page through
+// info of posts of a given author
 func GetPostsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	cmdr := commander{
 		storeName,
 		cdc,
 	}
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "posts <author>",
 		Short: "Query posts of an author",
 		RunE:  cmdr.getPostsCmd,
 	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetPostCommentsCmd returns a query post that will page through
+// comments of the post at a given author and postID
+func GetPostCommentsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "post-comments <author> <postID>",
+		Short: "Query comments of a post",
+		RunE:  cmdr.getPostCommentsCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetPostViewsCmd returns a query post that will page through
+// views of the post at a given author and postID
+func GetPostViewsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "post-views <author> <postID>",
+		Short: "Query views of a post",
+		RunE:  cmdr.getPostViewsCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetPostReportOrUpvotesCmd returns a query post that will page through
+// reports and upvotes of the post at a given author and postID
+func GetPostReportOrUpvotesCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "post-report-or-upvotes <author> <postID>",
+		Short: "Query reports and upvotes of a post",
+		RunE:  cmdr.getPostReportOrUpvotesCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetPostDonationsCmd returns a query post that will page through
+// donation records of the post at a given author and postID
+func GetPostDonationsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "post-donations <author> <postID>",
+		Short: "Query donations of a post",
+		RunE:  cmdr.getPostDonationsCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetPostsByTagCmd returns a query post that will page through
+// posts with a given tag in creation order
+func GetPostsByTagCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "posts-by-tag <tag>",
+		Short: "Query posts with a tag",
+		RunE:  cmdr.getPostsByTagCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetPostRevisionsCmd returns a query post that will display the
+// revision log of the post at a given author and postID
+func GetPostRevisionsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "post-revisions <author> <postID>",
+		Short: "Query revision log of a post",
+		RunE:  cmdr.getPostRevisionsCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+// GetSubscriptionsCmd returns a query that will display the
+// subscriptions of a given subscriber
+func GetSubscriptionsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "subscriptions <subscriber>",
+		Short: "Query subscriptions of a user",
+		RunE:  cmdr.getSubscriptionsCmd,
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().String(client.FlagStart, "", "show records from this cursor, the next of previous page")
+	cmd.Flags().Int64(client.FlagLimit, post.MaxPageSize, "max number of records in a page")
 }
 
 func (c commander) getPostsCmd(cmd *cobra.Command, args []string) error {
-	ctx := client.NewCoreContextFromViper()
 	if len(args) != 1 || len(args[0]) == 0 {
 		return errors.New("You must provide an valid author")
 	}
+	return c.queryPage(post.QueryPostsByAuthor, args[0], new(model.PostInfoPage))
+}
 
-	// find the key to look up the account
-	author := types.AccountKey(args[0])
+func (c commander) getPostsByTagCmd(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an valid tag")
+	}
+	return c.queryPage(post.QueryPostsByTag, args[0], new(model.TagPostPage))
+}
 
-	resKVs, err := ctx.QuerySubspace(
-		c.cdc, append(model.GetPostInfoPrefix(author), types.PermlinkSeparator...), c.storeName)
+func (c commander) getPostCommentsCmd(cmd *cobra.Command, args []string) error {
+	permlink, err := getPermlinkFromArgs(args)
 	if err != nil {
 		return err
 	}
-	var posts []model.PostInfo
-	for _, KV := range resKVs {
-		var info model.PostInfo
-		if err := c.cdc.UnmarshalBinaryLengthPrefixed(KV.Value, &info); err != nil {
-			return err
-		}
-		posts = append(posts, info)
+	return c.queryPage(post.QueryPostCommentPage, permlink, new(model.CommentPage))
+}
+
+func (c commander) getPostViewsCmd(cmd *cobra.Command, args []string) error {
+	permlink, err := getPermlinkFromArgs(args)
+	if err != nil {
+		return err
+	}
+	return c.queryPage(post.QueryPostViewPage, permlink, new(model.ViewPage))
+}
+
+func (c commander) getPostReportOrUpvotesCmd(cmd *cobra.Command, args []string) error {
+	permlink, err := getPermlinkFromArgs(args)
+	if err != nil {
+		return err
+	}
+	return c.queryPage(post.QueryPostReportOrUpvotePage, permlink, new(model.ReportOrUpvotePage))
+}
+
+func (c commander) getPostDonationsCmd(cmd *cobra.Command, args []string) error {
+	permlink, err := getPermlinkFromArgs(args)
+	if err != nil {
+		return err
+	}
+	return c.queryPage(post.QueryPostDonationPage, permlink, new(model.DonationPage))
+}
+
+func (c commander) getPostRevisionsCmd(cmd *cobra.Command, args []string) error {
+	permlink, err := getPermlinkFromArgs(args)
+	if err != nil {
+		return err
+	}
+	return c.queryPage(post.QueryPostRevisions, permlink, new(model.RevisionPage))
+}
+
+func (c commander) getSubscriptionsCmd(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a subscriber")
+	}
+	return c.queryPage(post.QuerySubscriptions, args[0], new(model.SubscriptionPage))
+}
+
+func getPermlinkFromArgs(args []string) (string, error) {
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return "", errors.New("You must provide an valid author and post id")
+	}
+	return string(types.GetPermlink(types.AccountKey(args[0]), args[1])), nil
+}
+
+// query one page of @p route under @p key and print it
+func (c commander) queryPage(route, key string, page interface{}) error {
+	ctx := client.NewCoreContextFromViper()
+	res, err := ctx.QueryRoute(fmt.Sprintf("%s/%s/%s/%s/%d",
+		post.QuerierRoute, route, key,
+		viper.GetString(client.FlagStart), viper.GetInt64(client.FlagLimit)))
+	if err != nil {
+		return err
+	}
+	if err := c.cdc.UnmarshalJSON(res, page); err != nil {
+		return err
 	}
 
-	if err := client.PrintIndent(posts); err != nil {
+	if err := client.PrintIndent(page); err != nil {
 		return err
 	}
 	return nil
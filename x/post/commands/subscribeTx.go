@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// SubscribeTxCmd will create a subscribe tx and sign it with the given key
+func SubscribeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe",
+		Short: "donate to a post periodically",
+		RunE:  sendSubscribeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDonator, "", "donator of this transaction")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().String(client.FlagAmount, "", "amount of each donation")
+	cmd.Flags().String(client.FlagMemo, "", "memo of each donation")
+	cmd.Flags().Int64(client.FlagInterval, types.MinimumSubscriptionIntervalSec, "seconds between two donations")
+	cmd.Flags().Int64(client.FlagTimes, 1, "number of donations")
+	return cmd
+}
+
+// CancelSubscriptionTxCmd will create a cancel subscription tx and sign it with the given key
+func CancelSubscriptionTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription",
+		Short: "cancel periodical donation to a post",
+		RunE:  sendCancelSubscriptionTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDonator, "", "donator of this transaction")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	return cmd
+}
+
+// send subscribe transaction to the blockchain
+func sendSubscribeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewSubscribeMsg(
+			viper.GetString(client.FlagDonator), types.LNO(viper.GetString(client.FlagAmount)),
+			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID), viper.GetString(client.FlagApp),
+			viper.GetString(client.FlagMemo), viper.GetInt64(client.FlagInterval),
+			viper.GetInt64(client.FlagTimes))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// send cancel subscription transaction to the blockchain
+func sendCancelSubscriptionTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewCancelSubscriptionMsg(
+			viper.GetString(client.FlagDonator), viper.GetString(client.FlagAuthor),
+			viper.GetString(client.FlagPostID))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -24,6 +24,8 @@ func UpdatePostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
 	cmd.Flags().String(client.FlagTitle, "", "title for the post")
 	cmd.Flags().String(client.FlagContent, "", "content for the post")
+	cmd.Flags().String(client.FlagTags, "", "comma separated tags of the post")
+	cmd.Flags().Bool(client.FlagKeepRevisionContent, false, "keep full content of the replaced version in revision log")
 	return cmd
 }
 
@@ -35,7 +37,8 @@ func sendUpdatePostTx(cdc *wire.Codec) client.CommandTxCallback {
 		msg := post.NewUpdatePostMsg(
 			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID),
 			viper.GetString(client.FlagTitle), viper.GetString(client.FlagContent),
-			[]types.IDToURLMapping(nil))
+			[]types.IDToURLMapping(nil), parseTags(viper.GetString(client.FlagTags)))
+		msg.KeepRevisionContent = viper.GetBool(client.FlagKeepRevisionContent)
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
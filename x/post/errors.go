@@ -43,6 +43,11 @@ func ErrGetSourcePost(permlink types.Permlink) sdk.Error {
 	return types.NewError(types.CodeGetSourcePost, fmt.Sprintf("failed to get source post %s", permlink))
 }
 
+// ErrAppMismatch - error when donation from app differs from the app co-signing the tx
+func ErrAppMismatch(fromApp, app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeAppMismatch, fmt.Sprintf("donation from app %s is co-signed by app %s", fromApp, app))
+}
+
 // ErrDeveloperNotFound - error when develoepr is not found
 func ErrDeveloperNotFound(fromApp types.AccountKey) sdk.Error {
 	return types.NewError(types.CodeDeveloperNotFound, fmt.Sprintf("developer %s is not found", fromApp))
@@ -128,6 +133,41 @@ func ErrTooManyURL() sdk.Error {
 	return types.NewError(types.CodeTooManyURL, fmt.Sprintf("too many url"))
 }
 
+// ErrTooManyPostTags - error if post has too many tags
+func ErrTooManyPostTags() sdk.Error {
+	return types.NewError(types.CodeTooManyPostTags, fmt.Sprintf("too many tags"))
+}
+
+// ErrInvalidPostTag - error if post tag is empty, too long or contains key separator
+func ErrInvalidPostTag(tag string) sdk.Error {
+	return types.NewError(types.CodeInvalidPostTag, fmt.Sprintf("invalid tag %v", tag))
+}
+
+// ErrDuplicatePostTag - error if post has duplicate tags
+func ErrDuplicatePostTag(tag string) sdk.Error {
+	return types.NewError(types.CodeDuplicatePostTag, fmt.Sprintf("duplicate tag %v", tag))
+}
+
+// ErrInvalidSubscriptionInterval - error if subscription interval is too short
+func ErrInvalidSubscriptionInterval(intervalSec int64) sdk.Error {
+	return types.NewError(types.CodeInvalidSubscriptionInterval, fmt.Sprintf("invalid subscription interval %v seconds", intervalSec))
+}
+
+// ErrInvalidSubscriptionPeriods - error if number of subscription periods is out of range
+func ErrInvalidSubscriptionPeriods(periods int64) sdk.Error {
+	return types.NewError(types.CodeInvalidSubscriptionPeriods, fmt.Sprintf("invalid subscription periods %v", periods))
+}
+
+// ErrSubscriptionAlreadyExist - error if user already subscribed to the post
+func ErrSubscriptionAlreadyExist(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeSubscriptionAlreadyExist, fmt.Sprintf("%v already subscribed to %v", user, permlink))
+}
+
+// ErrSubscriptionNotFound - error if subscription doesn't exist or is cancelled
+func ErrSubscriptionNotFound(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeSubscriptionNotFound, fmt.Sprintf("subscription of %v to %v not found", user, permlink))
+}
+
 // ErrPostTitleExceedMaxLength - error when post title is too long
 func ErrPostTitleExceedMaxLength() sdk.Error {
 	return types.NewError(types.CodePostTitleExceedMaxLength, fmt.Sprintf("post title exceeds max length limitation"))
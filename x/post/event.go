@@ -17,19 +17,22 @@ func init() {
 
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(SubscriptionEvent{}, "event/subscription", nil)
 }
 
 // RewardEvent - when donation occurred, a reward event will be register
 // at 7 days later. After 7 days reward event will be executed and send
-// inflation to author.
+// inflation to author. Consumption is credited to FromApp only if AppVerified,
+// which is true if the app co-signed the donation.
 type RewardEvent struct {
-	PostAuthor types.AccountKey `json:"post_author"`
-	PostID     string           `json:"post_id"`
-	Consumer   types.AccountKey `json:"consumer"`
-	Evaluate   types.Coin       `json:"evaluate"`
-	Original   types.Coin       `json:"original"`
-	Friction   types.Coin       `json:"friction"`
-	FromApp    types.AccountKey `json:"from_app"`
+	PostAuthor  types.AccountKey `json:"post_author"`
+	PostID      string           `json:"post_id"`
+	Consumer    types.AccountKey `json:"consumer"`
+	Evaluate    types.Coin       `json:"evaluate"`
+	Original    types.Coin       `json:"original"`
+	Friction    types.Coin       `json:"friction"`
+	FromApp     types.AccountKey `json:"from_app"`
+	AppVerified bool             `json:"app_verified"`
 }
 
 // Execute - execute reward event after 7 days
@@ -55,8 +58,8 @@ func (event RewardEvent) Execute(
 	if err != nil {
 		return err
 	}
-	// if developer exist, add to developer consumption
-	if dm.DoesDeveloperExist(ctx, event.FromApp) {
+	// if developer exist and co-signed the donation, add to developer consumption
+	if event.AppVerified && dm.DoesDeveloperExist(ctx, event.FromApp) {
 		dm.ReportConsumption(ctx, event.FromApp, reward)
 	}
 	if !am.DoesAccountExist(ctx, event.PostAuthor) {
@@ -74,5 +77,72 @@ func (event RewardEvent) Execute(
 		ctx, event.PostAuthor, event.Original, event.Friction, reward, event.Consumer, event.PostAuthor, event.PostID); err != nil {
 		return err
 	}
-	return nil
+	return gm.AddBalanceChange(ctx, event.PostAuthor, reward, types.PostReward)
+}
+
+// SubscriptionEvent - a payment of subscription is due. Payment is made as a donation
+// and the next payment is registered until all periods of the subscription are done.
+type SubscriptionEvent struct {
+	Subscriber types.AccountKey `json:"subscriber"`
+	Author     types.AccountKey `json:"author"`
+	PostID     string           `json:"post_id"`
+}
+
+// Execute - pay the subscription, a payment that can't be made is counted as missed
+// instead of failing the event, and none of its state changes are kept.
+// Returns tags of the donation if paid.
+func (event SubscriptionEvent) Execute(
+	ctx sdk.Context, pm PostManager, am acc.AccountManager,
+	gm *global.GlobalManager, rm rep.ReputationManager) (sdk.Tags, sdk.Error) {
+	permlink := types.GetPermlink(event.Author, event.PostID)
+	subscription, err := pm.GetSubscription(ctx, event.Subscriber, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.IsCancelled {
+		pm.DeleteSubscription(ctx, event.Subscriber, permlink)
+		return sdk.EmptyTags(), nil
+	}
+
+	tags := sdk.EmptyTags()
+	paid := false
+	if am.DoesAccountExist(ctx, event.Subscriber) && pm.DoesPostExist(ctx, permlink) {
+		if isDeleted, err := pm.IsDeleted(ctx, permlink); err == nil && !isDeleted {
+			// payment is written only if the whole donation succeeds.
+			cachedCtx, write := ctx.CacheContext()
+			eventCache := gm.SnapshotEventCache()
+			donationTags, err := processDonation(
+				cachedCtx, event.Subscriber, subscription.Amount, event.Author, event.PostID,
+				subscription.FromApp, subscription.AppVerified, subscription.Memo, am, pm, gm, rm)
+			if err == nil {
+				write()
+				paid = true
+				tags = donationTags
+			} else {
+				gm.RevertEventCache(eventCache)
+			}
+		}
+	}
+	if paid {
+		subscription.PaidPeriods++
+	} else {
+		subscription.MissedPeriods++
+	}
+
+	if subscription.PaidPeriods+subscription.MissedPeriods >= subscription.Periods {
+		pm.DeleteSubscription(ctx, event.Subscriber, permlink)
+		return tags, nil
+	}
+	subscription.NextPaymentAt += subscription.IntervalSec
+	// catch up if the chain is behind schedule
+	if subscription.NextPaymentAt < ctx.BlockHeader().Time.Unix() {
+		subscription.NextPaymentAt = ctx.BlockHeader().Time.Unix()
+	}
+	if err := pm.SetSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	if err := gm.RegisterSubscriptionEvent(ctx, subscription.NextPaymentAt, event); err != nil {
+		return nil, err
+	}
+	return tags, nil
 }
@@ -39,13 +39,14 @@ func TestRewardEvent(t *testing.T) {
 		{
 			testName: "normal event",
 			rewardEvent: RewardEvent{
-				PostAuthor: user,
-				PostID:     postID,
-				Consumer:   user1,
-				Evaluate:   types.NewCoinFromInt64(100),
-				Original:   types.NewCoinFromInt64(100),
-				Friction:   types.NewCoinFromInt64(15),
-				FromApp:    types.AccountKey("LinoApp1"),
+				PostAuthor:  user,
+				PostID:      postID,
+				Consumer:    user1,
+				Evaluate:    types.NewCoinFromInt64(100),
+				Original:    types.NewCoinFromInt64(100),
+				Friction:    types.NewCoinFromInt64(15),
+				FromApp:     types.AccountKey("LinoApp1"),
+				AppVerified: true,
 			},
 			initRewardPool:   types.NewCoinFromInt64(100),
 			initRewardWindow: types.NewCoinFromInt64(100),
@@ -69,13 +70,14 @@ func TestRewardEvent(t *testing.T) {
 		{
 			testName: "evaluate as 1% of total window",
 			rewardEvent: RewardEvent{
-				PostAuthor: user,
-				PostID:     postID,
-				Consumer:   user1,
-				Evaluate:   types.NewCoinFromInt64(1),
-				Original:   types.NewCoinFromInt64(100),
-				Friction:   types.NewCoinFromInt64(15),
-				FromApp:    types.AccountKey("LinoApp1"),
+				PostAuthor:  user,
+				PostID:      postID,
+				Consumer:    user1,
+				Evaluate:    types.NewCoinFromInt64(1),
+				Original:    types.NewCoinFromInt64(100),
+				Friction:    types.NewCoinFromInt64(15),
+				FromApp:     types.AccountKey("LinoApp1"),
+				AppVerified: true,
 			},
 			initRewardPool:   types.NewCoinFromInt64(100),
 			initRewardWindow: types.NewCoinFromInt64(100),
@@ -99,13 +101,14 @@ func TestRewardEvent(t *testing.T) {
 		{
 			testName: "reward from different app",
 			rewardEvent: RewardEvent{
-				PostAuthor: user,
-				PostID:     postID,
-				Consumer:   user1,
-				Evaluate:   types.NewCoinFromInt64(100),
-				Original:   types.NewCoinFromInt64(100),
-				Friction:   types.NewCoinFromInt64(15),
-				FromApp:    types.AccountKey("LinoApp2"),
+				PostAuthor:  user,
+				PostID:      postID,
+				Consumer:    user1,
+				Evaluate:    types.NewCoinFromInt64(100),
+				Original:    types.NewCoinFromInt64(100),
+				Friction:    types.NewCoinFromInt64(15),
+				FromApp:     types.AccountKey("LinoApp2"),
+				AppVerified: true,
 			},
 			initRewardPool:   types.NewCoinFromInt64(100),
 			initRewardWindow: types.NewCoinFromInt64(100),
@@ -129,13 +132,14 @@ func TestRewardEvent(t *testing.T) {
 		{
 			testName: "deleted post can't get any inflation",
 			rewardEvent: RewardEvent{
-				PostAuthor: user2,
-				PostID:     deletedPostID,
-				Consumer:   user1,
-				Evaluate:   types.NewCoinFromInt64(33333),
-				Original:   types.NewCoinFromInt64(100),
-				Friction:   types.NewCoinFromInt64(15),
-				FromApp:    types.AccountKey("LinoApp2"),
+				PostAuthor:  user2,
+				PostID:      deletedPostID,
+				Consumer:    user1,
+				Evaluate:    types.NewCoinFromInt64(33333),
+				Original:    types.NewCoinFromInt64(100),
+				Friction:    types.NewCoinFromInt64(15),
+				FromApp:     types.AccountKey("LinoApp2"),
+				AppVerified: true,
 			},
 			initRewardPool:   types.NewCoinFromInt64(5555),
 			initRewardWindow: types.NewCoinFromInt64(77777),
@@ -157,6 +161,37 @@ func TestRewardEvent(t *testing.T) {
 				UnclaimReward:   types.NewCoinFromInt64(0),
 			},
 		},
+		{
+			testName: "reward from unverified app",
+			rewardEvent: RewardEvent{
+				PostAuthor:  user,
+				PostID:      postID,
+				Consumer:    user1,
+				Evaluate:    types.NewCoinFromInt64(100),
+				Original:    types.NewCoinFromInt64(100),
+				Friction:    types.NewCoinFromInt64(15),
+				FromApp:     types.AccountKey("LinoApp1"),
+				AppVerified: false,
+			},
+			initRewardPool:   types.NewCoinFromInt64(100),
+			initRewardWindow: types.NewCoinFromInt64(100),
+			expectPostMeta: postModel.PostMeta{
+				TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
+				TotalReportCoinDay:      types.NewCoinFromInt64(0),
+				TotalDonateCount:        1,
+				TotalReward:             types.NewCoinFromInt64(100),
+				RedistributionSplitRate: sdk.ZeroDec(),
+				LastActivityAt:          ctx.BlockHeader().Time.Unix(),
+			},
+			expectAppWeight: types.NewDecFromRat(101, 201),
+			expectAuthorReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(100),
+				OriginalIncome:  types.NewCoinFromInt64(15),
+				FrictionIncome:  types.NewCoinFromInt64(15),
+				InflationIncome: types.NewCoinFromInt64(100),
+				UnclaimReward:   types.NewCoinFromInt64(100),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
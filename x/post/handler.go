@@ -31,6 +31,10 @@ func NewHandler(
 			return handleUpdatePostMsg(ctx, msg, pm, am)
 		case DeletePostMsg:
 			return handleDeletePostMsg(ctx, msg, pm, am)
+		case SubscribeMsg:
+			return handleSubscribeMsg(ctx, msg, pm, am, gm, dm)
+		case CancelSubscriptionMsg:
+			return handleCancelSubscriptionMsg(ctx, msg, pm)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized post msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -76,14 +80,14 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if err := pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
-		splitRate, msg.Links); err != nil {
+		splitRate, msg.Links, msg.Tags); err != nil {
 		return err.Result()
 	}
 
 	if err := am.UpdateLastPostAt(ctx, msg.Author); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewPostTags(msg.Author, permlink)}
 }
 
 // Handle ViewMsg
@@ -99,7 +103,7 @@ func handleViewMsg(ctx sdk.Context, msg ViewMsg, pm PostManager, am acc.AccountM
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewPostTags(msg.Username, permlink)}
 }
 
 // Handle DonateMsg
@@ -121,49 +125,153 @@ func handleDonateMsg(
 	if msg.Username == msg.Author {
 		return ErrCannotDonateToSelf(msg.Username).Result()
 	}
-	if msg.FromApp != "" {
-		if !dm.DoesDeveloperExist(ctx, msg.FromApp) {
-			return ErrDeveloperNotFound(msg.FromApp).Result()
+	// app co-signing the donation takes precedence over the claimed one,
+	// only consumption through a co-signing app is credited to the app.
+	fromApp, appVerified := msg.FromApp, false
+	if app := types.GetApp(ctx); app != "" {
+		if fromApp != "" && fromApp != app {
+			return ErrAppMismatch(fromApp, app).Result()
+		}
+		fromApp, appVerified = app, true
+	}
+	if fromApp != "" {
+		if !dm.DoesDeveloperExist(ctx, fromApp) {
+			return ErrDeveloperNotFound(fromApp).Result()
 		}
 	}
 
-	totalCoinDayDonated, err := am.MinusSavingCoinWithFullCoinDay(
-		ctx, msg.Username, coin, msg.Author, msg.Memo,
-		types.DonationOut)
+	tags, err := processDonation(
+		ctx, msg.Username, coin, msg.Author, msg.PostID, fromApp, appVerified, msg.Memo, am, pm, gm, rm)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: tags}
+}
+
+// Handle SubscribeMsg
+func handleSubscribeMsg(
+	ctx sdk.Context, msg SubscribeMsg, pm PostManager, am acc.AccountManager,
+	gm *global.GlobalManager, dm dev.DeveloperManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound(msg.Username).Result()
+	}
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return ErrDonatePostIsDeleted(permlink).Result()
+	}
+	if msg.Username == msg.Author {
+		return ErrCannotDonateToSelf(msg.Username).Result()
+	}
+	fromApp, appVerified := msg.FromApp, false
+	if app := types.GetApp(ctx); app != "" {
+		if fromApp != "" && fromApp != app {
+			return ErrAppMismatch(fromApp, app).Result()
+		}
+		fromApp, appVerified = app, true
+	}
+	if fromApp != "" {
+		if !dm.DoesDeveloperExist(ctx, fromApp) {
+			return ErrDeveloperNotFound(fromApp).Result()
+		}
+	}
+
+	subscription, err := pm.CreateSubscription(
+		ctx, msg.Username, msg.Author, msg.PostID, coin, fromApp, appVerified, msg.Memo,
+		msg.IntervalSec, msg.Periods)
 	if err != nil {
 		return err.Result()
 	}
+	event := SubscriptionEvent{
+		Subscriber: msg.Username,
+		Author:     msg.Author,
+		PostID:     msg.PostID,
+	}
+	if err := gm.RegisterSubscriptionEvent(ctx, subscription.NextPaymentAt, event); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: types.NewPostTags(msg.Username, permlink)}
+}
+
+// Handle CancelSubscriptionMsg
+func handleCancelSubscriptionMsg(ctx sdk.Context, msg CancelSubscriptionMsg, pm PostManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if err := pm.CancelSubscription(ctx, msg.Username, permlink); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Tags: types.NewPostTags(msg.Username, permlink)}
+}
 
-	// sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
-	// if err != nil {
-	// 	return err.Result()
-	// }
-	// if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
-	// 	sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
+// processDonation - transfer @p coin from @p username to the post and its root source post,
+// shared by donation and subscription payment. Consumption is credited to @p fromApp
+// only if @p appVerified.
+func processDonation(
+	ctx sdk.Context, username types.AccountKey, coin types.Coin,
+	author types.AccountKey, postID string, fromApp types.AccountKey, appVerified bool, memo string,
+	am acc.AccountManager, pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) (sdk.Tags, sdk.Error) {
+	permlink := types.GetPermlink(author, postID)
+	totalCoinDayDonated, err := am.MinusSavingCoinWithFullCoinDay(
+		ctx, username, coin, author, memo,
+		types.DonationOut)
+	if err != nil {
+		return nil, err
+	}
 
-	// 	redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
-	// 	if err != nil {
-	// 		return err.Result()
-	// 	}
-	// 	sourceIncome := types.DecToCoin(coin.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
-	// 	coin = coin.Minus(sourceIncome)
-	// 	sourceCoinDayGained := types.DecToCoin(totalCoinDayDonated.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
-	// 	totalCoinDayDonated = totalCoinDayDonated.Minus(sourceCoinDayGained)
-	// 	if err := processDonationFriction(
-	// 		ctx, msg.Username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID, msg.FromApp, am, pm, gm, rm); err != nil {
-	// 		return ErrProcessSourceDonation(sourcePermlink).Result()
-	// 	}
-	// }
+	tags := types.NewTransferTags(username, author, coin, types.DonationOut).
+		AppendTag(types.TagPermlink, string(permlink))
+	if pm.paramHolder.IsFeatureActive(ctx, types.FeatureRepostDonationRedistribution) {
+		sourceAuthor, sourcePostID, err := pm.GetRootSourcePost(ctx, permlink)
+		if err != nil {
+			return nil, ErrGetSourcePost(permlink)
+		}
+		// donator can't redistribute the donation to itself
+		if sourceAuthor != types.AccountKey("") && sourcePostID != "" && sourceAuthor != username {
+			sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
+			redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
+			if err != nil {
+				return nil, err
+			}
+			sourceIncome := types.DecToCoin(coin.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
+			coin = coin.Minus(sourceIncome)
+			sourceCoinDayGained := types.DecToCoin(
+				totalCoinDayDonated.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
+			totalCoinDayDonated = totalCoinDayDonated.Minus(sourceCoinDayGained)
+			if err := processDonationFriction(
+				ctx, username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID,
+				fromApp, appVerified, memo, am, pm, gm, rm); err != nil {
+				return nil, ErrProcessSourceDonation(sourcePermlink)
+			}
+			if !sourceIncome.IsZero() {
+				if err := pm.AddDonationRecord(
+					ctx, sourcePermlink, username, sourceIncome, fromApp, appVerified); err != nil {
+					return nil, err
+				}
+			}
+			tags = tags.AppendTag(types.TagReceiver, string(sourceAuthor)).
+				AppendTag(types.TagPermlink, string(sourcePermlink))
+		}
+	}
 	if err := processDonationFriction(
-		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, msg.Memo, am, pm, gm, rm); err != nil {
-		return ErrProcessDonation(permlink).Result()
+		ctx, username, coin, totalCoinDayDonated, author, postID, fromApp, appVerified, memo,
+		am, pm, gm, rm); err != nil {
+		return nil, ErrProcessDonation(permlink)
+	}
+	if err := pm.AddDonationRecord(ctx, permlink, username, coin, fromApp, appVerified); err != nil {
+		return nil, err
 	}
-	return sdk.Result{}
+	return tags, nil
 }
 
 func processDonationFriction(
 	ctx sdk.Context, consumer types.AccountKey, coin types.Coin, coinDayDonated types.Coin,
-	postAuthor types.AccountKey, postID string, fromApp types.AccountKey, memo string, am acc.AccountManager,
+	postAuthor types.AccountKey, postID string, fromApp types.AccountKey, appVerified bool, memo string,
+	am acc.AccountManager,
 	pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) sdk.Error {
 	postKey := types.GetPermlink(postAuthor, postID)
 	if coin.IsZero() {
@@ -176,7 +284,7 @@ func processDonationFriction(
 	frictionCoin := types.DecToCoin(coin.ToDec().Mul(consumptionFrictionRate))
 	// evaluate this consumption can get the result, the result is used to get inflation from pool
 	repInput := coinDayDonated
-	if ctx.BlockHeader().Height >= types.BlockchainUpgrade1Update5Height {
+	if pm.paramHolder.IsFeatureActive(ctx, types.FeatureCoinReputationInput) {
 		repInput = coin
 	}
 	dp, err := rm.DonateAt(ctx, consumer, postKey, repInput)
@@ -188,13 +296,14 @@ func processDonationFriction(
 		return err
 	}
 	rewardEvent := RewardEvent{
-		PostAuthor: postAuthor,
-		PostID:     postID,
-		Consumer:   consumer,
-		Evaluate:   evaluateResult,
-		Original:   coin,
-		Friction:   frictionCoin,
-		FromApp:    fromApp,
+		PostAuthor:  postAuthor,
+		PostID:      postID,
+		Consumer:    consumer,
+		Evaluate:    evaluateResult,
+		Original:    coin,
+		Friction:    frictionCoin,
+		FromApp:     fromApp,
+		AppVerified: appVerified,
 	}
 	if err := gm.AddFrictionAndRegisterContentRewardEvent(
 		ctx, rewardEvent, frictionCoin, evaluateResult); err != nil {
@@ -261,7 +370,7 @@ func handleReportOrUpvoteMsg(
 	if err := am.UpdateLastReportOrUpvoteAt(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewPostTags(msg.Username, permlink)}
 }
 
 func handleUpdatePostMsg(
@@ -278,10 +387,10 @@ func handleUpdatePostMsg(
 	}
 
 	if err := pm.UpdatePost(
-		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links); err != nil {
+		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags, msg.KeepRevisionContent); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewPostTags(msg.Author, permlink)}
 }
 
 func handleDeletePostMsg(
@@ -297,5 +406,5 @@ func handleDeletePostMsg(
 	if err := pm.DeletePost(ctx, permlink); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewPostTags(msg.Author, permlink)}
 }
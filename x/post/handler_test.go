@@ -42,7 +42,7 @@ func TestHandlerCreatePost(t *testing.T) {
 		RedistributionSplitRate: "0",
 	}
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, sdk.Result{Tags: types.NewPostTags(msg.Author, types.GetPermlink(msg.Author, msg.PostID))})
 	assert.True(t, pm.DoesPostExist(ctx, types.GetPermlink(msg.Author, msg.PostID)))
 
 	// test invlaid author
@@ -76,23 +76,23 @@ func TestHandlerUpdatePost(t *testing.T) {
 		wantResult sdk.Result
 	}{
 		"normal update": {
-			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil)),
-			wantResult: sdk.Result{},
+			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
+			wantResult: sdk.Result{Tags: types.NewPostTags(user, types.GetPermlink(user, postID))},
 		},
 		"update author doesn't exist": {
-			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrAccountNotFound("invalid").Result(),
 		},
 		"update post doesn't exist - invalid post ID": {
-			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user, "invalid")).Result(),
 		},
 		"update post doesn't exist - invalid author": {
-			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user2, postID)).Result(),
 		},
 		"update deleted post": {
-			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrUpdatePostIsDeleted(types.GetPermlink(user1, postID1)).Result(),
 		},
 	}
@@ -147,7 +147,7 @@ func TestHandlerDeletePost(t *testing.T) {
 				Author: user,
 				PostID: postID,
 			},
-			wantResult: sdk.Result{},
+			wantResult: sdk.Result{Tags: types.NewPostTags(user, types.GetPermlink(user, postID))},
 		},
 		"author doesn't exist": {
 			msg: DeletePostMsg{
@@ -206,7 +206,7 @@ func TestHandlerCreateComment(t *testing.T) {
 		RedistributionSplitRate: "0",
 	}
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, sdk.Result{Tags: types.NewPostTags(msg.Author, types.GetPermlink(msg.Author, msg.PostID))})
 
 	// after handler check KVStore
 	postInfo := model.PostInfo{
@@ -303,7 +303,7 @@ func TestHandlerRepost(t *testing.T) {
 	}
 	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: baseTime1})
 	result := handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, sdk.Result{Tags: types.NewPostTags(msg.Author, types.GetPermlink(msg.Author, msg.PostID))})
 
 	// after handler check KVStore
 	postInfo := model.PostInfo{
@@ -337,7 +337,7 @@ func TestHandlerRepost(t *testing.T) {
 	msg.SourcePostID = "repost"
 	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: baseTime2})
 	result = handler(ctx, msg)
-	assert.Equal(t, result, sdk.Result{})
+	assert.Equal(t, result, sdk.Result{Tags: types.NewPostTags(msg.Author, types.GetPermlink(msg.Author, msg.PostID))})
 
 	// after handler check KVStore
 	// check 2 depth repost
@@ -645,8 +645,14 @@ func TestHandlerPostDonate(t *testing.T) {
 		donateMsg := NewDonateMsg(
 			string(tc.donateUser), tc.amount, string(tc.toAuthor), tc.toPostID, "", memo1)
 		result := handler(ctx, donateMsg)
-		if !assert.Equal(t, tc.expectErr, result) {
-			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectErr)
+		wantResult := tc.expectErr
+		if wantResult.IsOK() {
+			coin, _ := types.LinoToCoin(tc.amount)
+			wantResult.Tags = types.NewTransferTags(tc.donateUser, tc.toAuthor, coin, types.DonationOut).
+				AppendTag(types.TagPermlink, string(types.GetPermlink(tc.toAuthor, tc.toPostID)))
+		}
+		if !assert.Equal(t, wantResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, wantResult)
 		}
 		if tc.expectErr.Code.IsOK() {
 			checkPostMeta(t, ctx, types.GetPermlink(tc.toAuthor, tc.toPostID), tc.expectPostMeta)
@@ -708,6 +714,130 @@ func TestHandlerPostDonate(t *testing.T) {
 	}
 }
 
+func TestHandlerDonateFromApp(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	developerParam, err := ph.GetDeveloperParam(ctx)
+	assert.Nil(t, err)
+
+	app := createTestAccount(t, ctx, am, "app")
+	assert.Nil(t, dm.RegisterDeveloper(ctx, app, developerParam.DeveloperMinDeposit, "", "", ""))
+	author := createTestAccount(t, ctx, am, "author")
+	donator := createTestAccount(t, ctx, am, "donator")
+	err = am.AddSavingCoin(
+		ctx, donator, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+
+	// post created through app co-signed tx records the app
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	appCtx := types.WithApp(ctx, app)
+	result := handler(appCtx, CreatePostMsg{
+		PostID:                  "postID",
+		Title:                   "title",
+		Content:                 "content",
+		Author:                  author,
+		RedistributionSplitRate: "0",
+	})
+	permlink := types.GetPermlink(author, "postID")
+	assert.Equal(t, sdk.Result{Tags: types.NewPostTags(author, permlink)}, result)
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, app, postInfo.App)
+
+	testCases := []struct {
+		testName            string
+		ctx                 sdk.Context
+		fromApp             string
+		expectErr           sdk.Result
+		expectEventApp      types.AccountKey
+		expectEventVerified bool
+	}{
+		{
+			testName:            "claimed app without co-signature",
+			ctx:                 ctx,
+			fromApp:             string(app),
+			expectErr:           sdk.Result{},
+			expectEventApp:      app,
+			expectEventVerified: false,
+		},
+		{
+			testName:            "co-signed by app",
+			ctx:                 appCtx,
+			fromApp:             "",
+			expectErr:           sdk.Result{},
+			expectEventApp:      app,
+			expectEventVerified: true,
+		},
+		{
+			testName:  "claimed app differs from co-signing app",
+			ctx:       appCtx,
+			fromApp:   "other",
+			expectErr: ErrAppMismatch("other", app).Result(),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(tc.ctx, NewDonateMsg(string(donator), "1", string(author), "postID", tc.fromApp, ""))
+		wantResult := tc.expectErr
+		if wantResult.IsOK() {
+			wantResult.Tags = types.NewTransferTags(
+				donator, author, types.NewCoinFromInt64(1*types.Decimals), types.DonationOut).
+				AppendTag(types.TagPermlink, string(permlink))
+		}
+		if !assert.Equal(t, wantResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, wantResult)
+		}
+		if !tc.expectErr.IsOK() {
+			continue
+		}
+		assert.Nil(t, gm.CommitEventCache(tc.ctx))
+		eventList := gm.GetTimeEventListAtTime(tc.ctx, tc.ctx.BlockHeader().Time.Unix()+3600*7*24)
+		event := eventList.Events[len(eventList.Events)-1].(RewardEvent)
+		if event.FromApp != tc.expectEventApp || event.AppVerified != tc.expectEventVerified {
+			t.Errorf("%s: diff event app, got %v %v, want %v %v", tc.testName,
+				event.FromApp, event.AppVerified, tc.expectEventApp, tc.expectEventVerified)
+		}
+	}
+
+	donations, err := pm.GetDonationRecords(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Donation{
+		{
+			Username:    donator,
+			Amount:      types.NewCoinFromInt64(1 * types.Decimals),
+			FromApp:     app,
+			AppVerified: false,
+			CreatedAt:   ctx.BlockHeader().Time.Unix(),
+		},
+		{
+			Username:    donator,
+			Amount:      types.NewCoinFromInt64(1 * types.Decimals),
+			FromApp:     app,
+			AppVerified: true,
+			CreatedAt:   ctx.BlockHeader().Time.Unix(),
+		},
+	}, donations)
+
+	// subscription is verified only if co-signed by app
+	interval := int64(types.MinimumSubscriptionIntervalSec)
+	result = handler(ctx, NewSubscribeMsg(
+		string(donator), "1", string(author), "postID", string(app), "", interval, 3))
+	assert.True(t, result.IsOK())
+	subscription, err := pm.GetSubscription(ctx, donator, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, app, subscription.FromApp)
+	assert.False(t, subscription.AppVerified)
+	pm.DeleteSubscription(ctx, donator, permlink)
+	result = handler(appCtx, NewSubscribeMsg(
+		string(donator), "1", string(author), "postID", "", "", interval, 3))
+	assert.True(t, result.IsOK())
+	subscription, err = pm.GetSubscription(ctx, donator, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, app, subscription.FromApp)
+	assert.True(t, subscription.AppVerified)
+}
+
 func BenchmarkNumDonate(b *testing.B) {
 	ctx := getContext(0)
 	ph := param.NewParamHolder(testParamKVStoreKey)
@@ -741,7 +871,7 @@ func BenchmarkNumDonate(b *testing.B) {
 	postManager.CreatePost(
 		ctx, types.AccountKey("user1"), "postID", "", "", "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		splitRate, []types.IDToURLMapping{}, nil)
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
@@ -749,113 +879,114 @@ func BenchmarkNumDonate(b *testing.B) {
 	}
 }
 
-// func TestHandlerRePostDonate(t *testing.T) {
-// 	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
-// 	postParam, _ := ph.GetPostParam(ctx)
-// 	handler := NewHandler(pm, am, &gm, dm, rm)
-
-// 	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.15")
-// 	user2 := createTestAccount(t, ctx, am, "user2")
-// 	user3 := createTestAccount(t, ctx, am, "user3")
-// 	err := am.AddSavingCoin(
-// 		ctx, user3, types.NewCoinFromInt64(123*types.Decimals),
-// 		referrer, "", types.TransferIn)
-// 	assert.Nil(t, err)
-// 	// repost
-// 	msg := CreatePostMsg{
-// 		PostID:       "repost",
-// 		Title:        string(make([]byte, 50)),
-// 		Content:      string(make([]byte, 1000)),
-// 		Author:       user2,
-// 		ParentAuthor: "",
-// 		ParentPostID: "",
-// 		SourceAuthor: user1,
-// 		SourcePostID: postID,
-// 		Links:        nil,
-// 		RedistributionSplitRate: "0",
-// 	}
-// 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
-// 	result := handler(ctx, msg)
-// 	assert.Equal(t, sdk.Result{}, result)
-
-// 	donateMsg := NewDonateMsg(
-// 		string(user3), types.LNO("100"), string(user2), "repost", "", memo1)
-// 	result = handler(ctx, donateMsg)
-// 	assert.Equal(t, sdk.Result{}, result)
-// 	eventList :=
-// 		gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24)
-
-// 	// after handler check KVStore
-// 	// check repost first
-// 	postInfo := model.PostInfo{
-// 		PostID:       msg.PostID,
-// 		Title:        msg.Title,
-// 		Content:      msg.Content,
-// 		Author:       msg.Author,
-// 		ParentAuthor: msg.ParentAuthor,
-// 		ParentPostID: msg.ParentPostID,
-// 		SourceAuthor: msg.SourceAuthor,
-// 		SourcePostID: msg.SourcePostID,
-// 		Links:        msg.Links,
-// 	}
-// 	totalReward := types.DecToCoin(sdk.NewDec(15 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	postMeta := model.PostMeta{
-// 		CreatedAt:               ctx.BlockHeader().Time.Unix(),
-// 		LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
-// 		LastActivityAt:          ctx.BlockHeader().Time.Unix(),
-// 		AllowReplies:            true,
-// 		TotalDonateCount:        1,
-// 		TotalReward:             totalReward,
-// 		TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
-// 		TotalReportCoinDay:      types.NewCoinFromInt64(0),
-// 		RedistributionSplitRate: sdk.ZeroDec(),
-// 	}
-// 	checkPostKVStore(t, ctx, types.GetPermlink(user2, "repost"), postInfo, postMeta)
-// 	repostRewardEvent := RewardEvent{
-// 		PostAuthor: user2,
-// 		PostID:     "repost",
-// 		Consumer:   user3,
-// 		// XXX(yumin): rep is 1, while donation is 100, final dp is 1/100.
-// 		Evaluate: types.NewCoinFromInt64(15 * types.Decimals / 100),
-// 		Original: types.NewCoinFromInt64(15 * types.Decimals),
-// 		Friction: types.NewCoinFromInt64(75000),
-// 		FromApp:  "",
-// 	}
-// 	assert.Equal(t, repostRewardEvent, eventList.Events[1])
-
-// 	// check source post
-// 	postMeta.TotalReward = types.DecToCoin(sdk.NewDec(85 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	postMeta.CreatedAt = 0
-// 	postMeta.LastUpdatedAt = 0
-// 	postInfo.Author = user1
-// 	postInfo.PostID = postID
-// 	postInfo.SourceAuthor = ""
-// 	postInfo.SourcePostID = ""
-// 	postMeta.RedistributionSplitRate = types.NewDecFromRat(3, 20)
-// 	postMeta.TotalUpvoteCoinDay = types.NewCoinFromInt64(0)
-
-// 	checkPostKVStore(t, ctx, types.GetPermlink(user1, postID), postInfo, postMeta)
-
-// 	acc1Saving, _ := am.GetSavingFromBank(ctx, user1)
-// 	acc2Saving, _ := am.GetSavingFromBank(ctx, user2)
-// 	acc3Saving, _ := am.GetSavingFromBank(ctx, user3)
-// 	acc1SavingCoin := types.DecToCoin(sdk.NewDec(85 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	acc2SavingCoin := types.DecToCoin(sdk.NewDec(15 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	assert.Equal(t, acc1Saving, initCoin.Plus(acc1SavingCoin))
-// 	assert.Equal(t, acc2Saving, initCoin.Plus(acc2SavingCoin))
-// 	assert.Equal(t, acc3Saving, initCoin.Plus(types.NewCoinFromInt64(23*types.Decimals)))
-
-// 	sourceRewardEvent := RewardEvent{
-// 		PostAuthor: user1,
-// 		PostID:     postID,
-// 		Consumer:   user3,
-// 		Evaluate:   types.NewCoinFromInt64(85 * types.Decimals / 100),
-// 		Original:   types.NewCoinFromInt64(85 * types.Decimals),
-// 		Friction:   types.NewCoinFromInt64(425000),
-// 		FromApp:    "",
-// 	}
-// 	assert.Equal(t, sourceRewardEvent, eventList.Events[0])
-// }
+func TestHandlerRePostDonate(t *testing.T) {
+	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.15")
+	user2, repostID := createTestRepost(t, ctx, "user2", "repost", am, pm, user1, postID)
+	user3 := createTestAccount(t, ctx, am, "user3")
+	err := am.AddSavingCoin(
+		ctx, user3, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	// legacy repost of repost which still points to the intermediate post
+	user4, legacyRepostID := createTestRepost(t, ctx, "user4", "legacy", am, pm, user1, postID)
+	legacyPermlink := types.GetPermlink(user4, legacyRepostID)
+	legacyInfo, err := pm.postStorage.GetPostInfo(ctx, legacyPermlink)
+	assert.Nil(t, err)
+	legacyInfo.SourceAuthor = user2
+	legacyInfo.SourcePostID = repostID
+	assert.Nil(t, pm.postStorage.SetPostInfo(ctx, legacyInfo))
+
+	sourcePermlink := types.GetPermlink(user1, postID)
+	testCases := []struct {
+		testName            string
+		height              int64
+		toAuthor            types.AccountKey
+		toPostID            string
+		expectAuthorIncome  types.Coin
+		expectSourceIncome  types.Coin
+		expectRewardAuthors []types.AccountKey
+	}{
+		{
+			testName:            "no redistribution before upgrade",
+			height:              types.BlockchainUpgrade1Update6Height - 1,
+			toAuthor:            user2,
+			toPostID:            repostID,
+			expectAuthorIncome:  types.NewCoinFromInt64(95 * types.Decimals / 10),
+			expectSourceIncome:  types.NewCoinFromInt64(0),
+			expectRewardAuthors: []types.AccountKey{user2},
+		},
+		{
+			testName:            "redistribute to source after upgrade",
+			height:              types.BlockchainUpgrade1Update6Height,
+			toAuthor:            user2,
+			toPostID:            repostID,
+			expectAuthorIncome:  types.DecToCoin(sdk.NewDec(15 * types.Decimals / 10).Mul(types.NewDecFromRat(95, 100))),
+			expectSourceIncome:  types.DecToCoin(sdk.NewDec(85 * types.Decimals / 10).Mul(types.NewDecFromRat(95, 100))),
+			expectRewardAuthors: []types.AccountKey{user1, user2},
+		},
+		{
+			testName:            "multi-hop repost resolves to root",
+			height:              types.BlockchainUpgrade1Update6Height,
+			toAuthor:            user4,
+			toPostID:            legacyRepostID,
+			expectAuthorIncome:  types.DecToCoin(sdk.NewDec(15 * types.Decimals / 10).Mul(types.NewDecFromRat(95, 100))),
+			expectSourceIncome:  types.DecToCoin(sdk.NewDec(85 * types.Decimals / 10).Mul(types.NewDecFromRat(95, 100))),
+			expectRewardAuthors: []types.AccountKey{user1, user4},
+		},
+	}
+	for i, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Height: tc.height, Time: time.Unix(int64(i+1)*3600, 0)})
+		authorSaving, err := am.GetSavingFromBank(ctx, tc.toAuthor)
+		assert.Nil(t, err)
+		sourceSaving, err := am.GetSavingFromBank(ctx, user1)
+		assert.Nil(t, err)
+		sourceDonations, err := pm.GetDonationRecords(ctx, sourcePermlink)
+		assert.Nil(t, err)
+
+		result := handler(ctx, NewDonateMsg(string(user3), "10", string(tc.toAuthor), tc.toPostID, "", ""))
+		if !result.IsOK() {
+			t.Errorf("%s: donate failed, got %v", tc.testName, result)
+			continue
+		}
+
+		newAuthorSaving, err := am.GetSavingFromBank(ctx, tc.toAuthor)
+		assert.Nil(t, err)
+		if !newAuthorSaving.Minus(authorSaving).IsEqual(tc.expectAuthorIncome) {
+			t.Errorf("%s: diff author income, got %v, want %v",
+				tc.testName, newAuthorSaving.Minus(authorSaving), tc.expectAuthorIncome)
+		}
+		newSourceSaving, err := am.GetSavingFromBank(ctx, user1)
+		assert.Nil(t, err)
+		if !newSourceSaving.Minus(sourceSaving).IsEqual(tc.expectSourceIncome) {
+			t.Errorf("%s: diff source income, got %v, want %v",
+				tc.testName, newSourceSaving.Minus(sourceSaving), tc.expectSourceIncome)
+		}
+		newSourceDonations, err := pm.GetDonationRecords(ctx, sourcePermlink)
+		assert.Nil(t, err)
+		expectSourceRecords := len(sourceDonations)
+		if !tc.expectSourceIncome.IsZero() {
+			expectSourceRecords++
+		}
+		if len(newSourceDonations) != expectSourceRecords {
+			t.Errorf("%s: diff source donation records, got %v, want %v",
+				tc.testName, len(newSourceDonations), expectSourceRecords)
+		}
+
+		assert.Nil(t, gm.CommitEventCache(ctx))
+		eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24)
+		if !assert.Equal(t, len(tc.expectRewardAuthors), len(eventList.Events)) {
+			continue
+		}
+		for j, author := range tc.expectRewardAuthors {
+			event := eventList.Events[j].(RewardEvent)
+			if event.PostAuthor != author || event.Consumer != user3 {
+				t.Errorf("%s: diff reward event, got %v, want author %v", tc.testName, event, author)
+			}
+		}
+	}
+}
 
 // reputation check should be added later
 func TestHandlerReportOrUpvote(t *testing.T) {
@@ -888,7 +1019,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         sdk.Result{Tags: types.NewPostTags(user1, types.GetPermlink(user1, postID))},
 		},
 		{
 			testName:             "user2 report",
@@ -897,7 +1028,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         sdk.Result{Tags: types.NewPostTags(user2, types.GetPermlink(user1, postID))},
 		},
 		{
 			testName:             "user3 upvote",
@@ -906,7 +1037,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         sdk.Result{Tags: types.NewPostTags(user3, types.GetPermlink(user1, postID))},
 		},
 		{
 			testName:             "user1 wanna change report to upvote",
@@ -915,7 +1046,7 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			targetPostAuthor:     string(user1),
 			targetPostID:         postID,
 			lastReportOrUpvoteAt: baseTime - postParam.ReportOrUpvoteIntervalSec,
-			expectResult:         sdk.Result{},
+			expectResult:         sdk.Result{Tags: types.NewPostTags(user1, types.GetPermlink(user1, postID))},
 		},
 		{
 			testName:             "user1 report too often",
@@ -1042,8 +1173,9 @@ func TestHandlerView(t *testing.T) {
 		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.viewTime, 0)})
 		msg := NewViewMsg(string(tc.viewUser), string(tc.author), tc.postID)
 		result := handler(ctx, msg)
-		if !assert.Equal(t, result, sdk.Result{}) {
-			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, sdk.Result{})
+		wantResult := sdk.Result{Tags: types.NewPostTags(tc.viewUser, postKey)}
+		if !assert.Equal(t, result, wantResult) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, wantResult)
 		}
 
 		postMeta := model.PostMeta{
@@ -1070,3 +1202,85 @@ func TestHandlerView(t *testing.T) {
 		}
 	}
 }
+
+func TestHandlerSubscription(t *testing.T) {
+	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	subscriber := createTestAccount(t, ctx, am, "subscriber")
+	permlink := types.GetPermlink(author, postID)
+	interval := int64(types.MinimumSubscriptionIntervalSec)
+
+	// subscriber can only afford the first payment
+	msg := NewSubscribeMsg(string(subscriber), "1", string(author), postID, "", "monthly", interval, 3)
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{Tags: types.NewPostTags(subscriber, permlink)}, result)
+	result = handler(ctx, msg)
+	assert.Equal(t, ErrSubscriptionAlreadyExist(subscriber, permlink).Result(), result)
+	result = handler(ctx, NewSubscribeMsg(string(author), "1", string(author), postID, "", "", interval, 3))
+	assert.Equal(t, ErrCannotDonateToSelf(author).Result(), result)
+
+	// first payment is due at subscription time
+	assert.Nil(t, gm.CommitEventCache(ctx))
+	eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix())
+	assert.Equal(t, 1, len(eventList.Events))
+	event := eventList.Events[0].(SubscriptionEvent)
+	assert.Equal(t, SubscriptionEvent{Subscriber: subscriber, Author: author, PostID: postID}, event)
+
+	tags, err := event.Execute(ctx, pm, am, &gm, rm)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewTransferTags(
+		subscriber, author, types.NewCoinFromInt64(1*types.Decimals), types.DonationOut).
+		AppendTag(types.TagPermlink, string(permlink)), tags)
+	saving, err := am.GetSavingFromBank(ctx, subscriber)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), saving)
+	donations, err := pm.GetDonationRecords(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(donations))
+	subscription, err := pm.GetSubscription(ctx, subscriber, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), subscription.PaidPeriods)
+	assert.Equal(t, ctx.BlockHeader().Time.Unix()+interval, subscription.NextPaymentAt)
+	assert.Nil(t, gm.CommitEventCache(ctx))
+	eventList = gm.GetTimeEventListAtTime(ctx, subscription.NextPaymentAt)
+	assert.Equal(t, []types.Event{event}, eventList.Events)
+
+	// payment is missed if subscriber can't afford it
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(subscription.NextPaymentAt, 0)})
+	tags, err = event.Execute(ctx, pm, am, &gm, rm)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.EmptyTags(), tags)
+	subscription, err = pm.GetSubscription(ctx, subscriber, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), subscription.PaidPeriods)
+	assert.Equal(t, int64(1), subscription.MissedPeriods)
+
+	// subscription is removed after the last period
+	err = am.AddSavingCoin(
+		ctx, subscriber, types.NewCoinFromInt64(1*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(subscription.NextPaymentAt, 0)})
+	_, err = event.Execute(ctx, pm, am, &gm, rm)
+	assert.Nil(t, err)
+	assert.False(t, pm.postStorage.DoesSubscriptionExist(ctx, subscriber, permlink))
+	donations, err = pm.GetDonationRecords(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(donations))
+
+	// cancelled subscription is removed when next payment is due
+	result = handler(ctx, msg)
+	assert.True(t, result.IsOK())
+	cancelMsg := NewCancelSubscriptionMsg(string(subscriber), string(author), postID)
+	result = handler(ctx, cancelMsg)
+	assert.Equal(t, sdk.Result{Tags: types.NewPostTags(subscriber, permlink)}, result)
+	result = handler(ctx, cancelMsg)
+	assert.Equal(t, ErrSubscriptionNotFound(subscriber, permlink).Result(), result)
+	result = handler(ctx, msg)
+	assert.Equal(t, ErrSubscriptionAlreadyExist(subscriber, permlink).Result(), result)
+	tags, err = event.Execute(ctx, pm, am, &gm, rm)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.EmptyTags(), tags)
+	assert.False(t, pm.postStorage.DoesSubscriptionExist(ctx, subscriber, permlink))
+}
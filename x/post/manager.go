@@ -60,13 +60,34 @@ func (pm PostManager) GetSourcePost(
 	return postInfo.SourceAuthor, postInfo.SourcePostID, nil
 }
 
+// GetRootSourcePost - follow the repost chain and return the root source post,
+// return empty author and post id if the post is not a repost
+func (pm PostManager) GetRootSourcePost(
+	ctx sdk.Context, permlink types.Permlink) (types.AccountKey, string, sdk.Error) {
+	rootAuthor, rootPostID := types.AccountKey(""), ""
+	for {
+		sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
+		if err != nil {
+			return types.AccountKey(""), "", err
+		}
+		if sourceAuthor == types.AccountKey("") || sourcePostID == "" {
+			return rootAuthor, rootPostID, nil
+		}
+		nextPermlink := types.GetPermlink(sourceAuthor, sourcePostID)
+		if nextPermlink == permlink {
+			return rootAuthor, rootPostID, nil
+		}
+		rootAuthor, rootPostID, permlink = sourceAuthor, sourcePostID, nextPermlink
+	}
+}
+
 func (pm PostManager) setRootSourcePost(ctx sdk.Context, postInfo *model.PostInfo) sdk.Error {
 	if postInfo.SourceAuthor == types.AccountKey("") || postInfo.SourcePostID == "" {
 		return nil
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
 	rootAuthor, rootPostID, err :=
-		pm.GetSourcePost(ctx, types.GetPermlink(postInfo.SourceAuthor, postInfo.SourcePostID))
+		pm.GetRootSourcePost(ctx, types.GetPermlink(postInfo.SourceAuthor, postInfo.SourcePostID))
 	if err != nil {
 		return ErrGetSourcePost(permlink)
 	}
@@ -83,7 +104,7 @@ func (pm PostManager) CreatePost(
 	sourceAuthor types.AccountKey, sourcePostID string,
 	parentAuthor types.AccountKey, parentPostID string,
 	content string, title string, redistributionSplitRate sdk.Dec,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string) sdk.Error {
 	postInfo := &model.PostInfo{
 		PostID:       postID,
 		Title:        title,
@@ -94,6 +115,8 @@ func (pm PostManager) CreatePost(
 		SourceAuthor: sourceAuthor,
 		SourcePostID: sourcePostID,
 		Links:        links,
+		App:          types.GetApp(ctx),
+		Tags:         tags,
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
 	if pm.DoesPostExist(ctx, permlink) {
@@ -116,13 +139,18 @@ func (pm PostManager) CreatePost(
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
 		return err
 	}
+	for _, tag := range tags {
+		pm.postStorage.SetPostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
 	return nil
 }
 
-// UpdatePost - update post title, content and links. Can't update a deleted post
+// UpdatePost - update post title, content, links and tags. Can't update a deleted post
+// The replaced version is appended to the post revision log with its content hash,
+// full content of the replaced version is kept only if @p keepRevisionContent.
 func (pm PostManager) UpdatePost(
 	ctx sdk.Context, author types.AccountKey, postID, title, content string,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string, keepRevisionContent bool) sdk.Error {
 	permlink := types.GetPermlink(author, postID)
 	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
 	if err != nil {
@@ -133,9 +161,32 @@ func (pm PostManager) UpdatePost(
 		return err
 	}
 
+	revision := &model.PostRevision{
+		Revision:   pm.postStorage.GetCurrentPostRevision(ctx, permlink),
+		CreatedAt:  postMeta.LastUpdatedAt,
+		ReplacedAt: ctx.BlockHeader().Time.Unix(),
+		Hash:       model.GetPostContentHash(postInfo.Title, postInfo.Content, postInfo.Links),
+	}
+	if keepRevisionContent {
+		revision.Title = postInfo.Title
+		revision.Content = postInfo.Content
+		revision.Links = postInfo.Links
+	}
+	if err := pm.postStorage.AddPostRevision(ctx, permlink, revision); err != nil {
+		return err
+	}
+
 	postInfo.Title = title
 	postInfo.Content = content
 	postInfo.Links = links
+	// replace tag index entries, posts keep their creation order under each tag
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.DeletePostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	for _, tag := range tags {
+		pm.postStorage.SetPostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	postInfo.Tags = tags
 	// postMeta.RedistributionSplitRate = redistributionSplitRate
 	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
 
@@ -211,6 +262,26 @@ func (pm PostManager) AddDonation(
 	return nil
 }
 
+// AddDonationRecord - record a donation to the post from @p donator through @p fromApp,
+// @p appVerified if the app co-signed the donation.
+func (pm PostManager) AddDonationRecord(
+	ctx sdk.Context, permlink types.Permlink, donator types.AccountKey,
+	amount types.Coin, fromApp types.AccountKey, appVerified bool) sdk.Error {
+	donation := &model.Donation{
+		Username:    donator,
+		Amount:      amount,
+		FromApp:     fromApp,
+		AppVerified: appVerified,
+		CreatedAt:   ctx.BlockHeader().Time.Unix(),
+	}
+	return pm.postStorage.AddPostDonation(ctx, permlink, donation)
+}
+
+// GetDonationRecords - returns donation records of the post.
+func (pm PostManager) GetDonationRecords(ctx sdk.Context, permlink types.Permlink) ([]model.Donation, sdk.Error) {
+	return pm.postStorage.GetPostDonations(ctx, permlink)
+}
+
 // DeletePost - delete post by author or content censorship
 func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -230,6 +301,23 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	postInfo.Title = ""
 	postInfo.Content = ""
 	postInfo.Links = nil
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.DeletePostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	postInfo.Tags = nil
+	// content of previous revisions is removed with the post, hashes are kept.
+	revisions, err := pm.postStorage.GetPostRevisions(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		revision.Title = ""
+		revision.Content = ""
+		revision.Links = nil
+		if err := pm.postStorage.SetPostRevision(ctx, permlink, &revision); err != nil {
+			return err
+		}
+	}
 
 	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
 		return err
@@ -237,6 +325,87 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	return nil
 }
 
+// GetCurrentPostRevision - get revision number of the current post content
+func (pm PostManager) GetCurrentPostRevision(ctx sdk.Context, permlink types.Permlink) int64 {
+	return pm.postStorage.GetCurrentPostRevision(ctx, permlink)
+}
+
+// GetPostRevisionHash - get content hash of the post at @p revision,
+// which is either the current revision or a revision still in the revision log.
+func (pm PostManager) GetPostRevisionHash(
+	ctx sdk.Context, permlink types.Permlink, revision int64) (string, sdk.Error) {
+	if revision == pm.postStorage.GetCurrentPostRevision(ctx, permlink) {
+		postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+		if err != nil {
+			return "", err
+		}
+		return model.GetPostContentHash(postInfo.Title, postInfo.Content, postInfo.Links), nil
+	}
+	postRevision, err := pm.postStorage.GetPostRevision(ctx, permlink, revision)
+	if err != nil {
+		return "", err
+	}
+	return postRevision.Hash, nil
+}
+
+// CreateSubscription - create a subscription from @p subscriber to the post,
+// the first payment is due at current block time.
+func (pm PostManager) CreateSubscription(
+	ctx sdk.Context, subscriber, author types.AccountKey, postID string, amount types.Coin,
+	fromApp types.AccountKey, appVerified bool, memo string,
+	intervalSec, periods int64) (*model.Subscription, sdk.Error) {
+	permlink := types.GetPermlink(author, postID)
+	if pm.postStorage.DoesSubscriptionExist(ctx, subscriber, permlink) {
+		return nil, ErrSubscriptionAlreadyExist(subscriber, permlink)
+	}
+	subscription := &model.Subscription{
+		Subscriber:    subscriber,
+		Author:        author,
+		PostID:        postID,
+		Amount:        amount,
+		FromApp:       fromApp,
+		AppVerified:   appVerified,
+		Memo:          memo,
+		IntervalSec:   intervalSec,
+		Periods:       periods,
+		CreatedAt:     ctx.BlockHeader().Time.Unix(),
+		NextPaymentAt: ctx.BlockHeader().Time.Unix(),
+	}
+	if err := pm.postStorage.SetSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// CancelSubscription - cancel subscription from @p subscriber to the post,
+// the subscription is removed when its next payment is due.
+func (pm PostManager) CancelSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, permlink types.Permlink) sdk.Error {
+	subscription, err := pm.postStorage.GetSubscription(ctx, subscriber, permlink)
+	if err != nil || subscription.IsCancelled {
+		return ErrSubscriptionNotFound(subscriber, permlink)
+	}
+	subscription.IsCancelled = true
+	return pm.postStorage.SetSubscription(ctx, subscription)
+}
+
+// GetSubscription - get subscription from @p subscriber to the post
+func (pm PostManager) GetSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, permlink types.Permlink) (*model.Subscription, sdk.Error) {
+	return pm.postStorage.GetSubscription(ctx, subscriber, permlink)
+}
+
+// SetSubscription - set subscription
+func (pm PostManager) SetSubscription(ctx sdk.Context, subscription *model.Subscription) sdk.Error {
+	return pm.postStorage.SetSubscription(ctx, subscription)
+}
+
+// DeleteSubscription - remove subscription from @p subscriber to the post
+func (pm PostManager) DeleteSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, permlink types.Permlink) {
+	pm.postStorage.DeleteSubscription(ctx, subscriber, permlink)
+}
+
 // IsDeleted - check if a post is deleted or not
 func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -292,3 +461,13 @@ func (pm PostManager) Export(ctx sdk.Context) *model.PostTables {
 func (pm PostManager) Import(ctx sdk.Context, tb *model.PostTablesIR) {
 	pm.postStorage.Import(ctx, tb)
 }
+
+// ExportRows - adaptor to storage ExportRows.
+func (pm PostManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	pm.postStorage.ExportRows(ctx, write)
+}
+
+// ImportRow - adaptor to storage ImportRow.
+func (pm PostManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	pm.postStorage.ImportRow(ctx, table, read)
+}
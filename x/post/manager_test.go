@@ -99,7 +99,7 @@ func TestCreatePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroDec(), msg.Links)
+			msg.Title, sdk.ZeroDec(), msg.Links, msg.Tags)
 		if !assert.Equal(t, err, tc.expectResult) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, err, tc.expectResult)
 		}
@@ -149,7 +149,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "normal update",
 			msg: NewUpdatePostMsg(
 				string(user), postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  nil,
 			updateTime: baseTime + 10,
 		},
@@ -157,7 +157,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid post id",
 			msg: NewUpdatePostMsg(
 				"invalid", postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink("invalid", postID))),
 			updateTime: baseTime + 100,
 		},
@@ -165,7 +165,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid author",
 			msg: NewUpdatePostMsg(
 				string(user), "invalid", "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink(user, "invalid"))),
 			updateTime: baseTime + 1000,
 		},
@@ -175,7 +175,7 @@ func TestUpdatePost(t *testing.T) {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.updateTime, 0)})
 
 		err := pm.UpdatePost(
-			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links)
+			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links, tc.msg.Tags, tc.msg.KeepRevisionContent)
 		if !assert.Equal(t, err, tc.expectErr) {
 			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
 		}
@@ -279,7 +279,7 @@ func TestGetSourcePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroDec(), msg.Links)
+			msg.Title, sdk.ZeroDec(), msg.Links, msg.Tags)
 		if err != nil {
 			t.Errorf("%s: failed to create post, got err %v", tc.testName, err)
 		}
@@ -531,6 +531,124 @@ func checkIsDelete(t *testing.T, ctx sdk.Context, pm PostManager, permlink types
 	assert.Equal(t, "", postInfo.Content)
 }
 
+func TestPostTagIndex(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user := createTestAccount(t, ctx, am, "user")
+	permlink1 := types.GetPermlink(user, "post1")
+	permlink2 := types.GetPermlink(user, "post2")
+
+	getTagPosts := func(tag string) []types.Permlink {
+		page, err := pm.postStorage.GetTagPostPage(ctx, tag, nil, 10)
+		assert.Nil(t, err)
+		permlinks := []types.Permlink{}
+		for _, postInfo := range page.Posts {
+			permlinks = append(permlinks, types.GetPermlink(postInfo.Author, postInfo.PostID))
+		}
+		return permlinks
+	}
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(1, 0)})
+	err := pm.CreatePost(
+		ctx, user, "post2", "", "", "", "", "content", "title",
+		sdk.ZeroDec(), nil, []string{"music", "art"})
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(2, 0)})
+	err = pm.CreatePost(
+		ctx, user, "post1", "", "", "", "", "content", "title",
+		sdk.ZeroDec(), nil, []string{"music"})
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{permlink2, permlink1}, getTagPosts("music"))
+	assert.Equal(t, []types.Permlink{permlink2}, getTagPosts("art"))
+
+	// update keeps creation order and replaces tags
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(3, 0)})
+	err = pm.UpdatePost(ctx, user, "post2", "title", "content", nil, []string{"music", "news"}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{permlink2, permlink1}, getTagPosts("music"))
+	assert.Equal(t, []types.Permlink{}, getTagPosts("art"))
+	assert.Equal(t, []types.Permlink{permlink2}, getTagPosts("news"))
+
+	err = pm.DeletePost(ctx, permlink2)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{permlink1}, getTagPosts("music"))
+	assert.Equal(t, []types.Permlink{}, getTagPosts("news"))
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink2)
+	assert.Nil(t, err)
+	assert.Nil(t, postInfo.Tags)
+}
+
+func TestPostRevision(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	baseTime := time.Now().Unix()
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	originHash := model.GetPostContentHash(postInfo.Title, postInfo.Content, postInfo.Links)
+	assert.Equal(t, int64(1), pm.GetCurrentPostRevision(ctx, permlink))
+	hash, err := pm.GetPostRevisionHash(ctx, permlink, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, originHash, hash)
+
+	links := []types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+10, 0)})
+	err = pm.UpdatePost(ctx, user, postID, "title1", "content1", links, nil, true)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+20, 0)})
+	err = pm.UpdatePost(ctx, user, postID, "title2", "content2", nil, nil, false)
+	assert.Nil(t, err)
+
+	revisions, err := pm.postStorage.GetPostRevisions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.PostRevision{
+		{
+			Revision:   1,
+			CreatedAt:  baseTime,
+			ReplacedAt: baseTime + 10,
+			Hash:       originHash,
+			Title:      postInfo.Title,
+			Content:    postInfo.Content,
+		},
+		{
+			Revision:   2,
+			CreatedAt:  baseTime + 10,
+			ReplacedAt: baseTime + 20,
+			Hash:       model.GetPostContentHash("title1", "content1", links),
+		},
+	}, revisions)
+	assert.Equal(t, int64(3), pm.GetCurrentPostRevision(ctx, permlink))
+	hash, err = pm.GetPostRevisionHash(ctx, permlink, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, model.GetPostContentHash("title2", "content2", nil), hash)
+	_, err = pm.GetPostRevisionHash(ctx, permlink, 4)
+	assert.NotNil(t, err)
+
+	// revision log is bounded, the oldest revisions are dropped
+	for i := 0; i < types.MaximumNumOfPostRevisions; i++ {
+		err = pm.UpdatePost(ctx, user, postID, "title", "content", nil, nil, true)
+		assert.Nil(t, err)
+	}
+	revisions, err = pm.postStorage.GetPostRevisions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, types.MaximumNumOfPostRevisions, len(revisions))
+	assert.Equal(t, int64(3), revisions[0].Revision)
+	_, err = pm.GetPostRevisionHash(ctx, permlink, 2)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(types.MaximumNumOfPostRevisions+3), pm.GetCurrentPostRevision(ctx, permlink))
+
+	// content of revisions is removed with the post
+	err = pm.DeletePost(ctx, permlink)
+	assert.Nil(t, err)
+	revisions, err = pm.postStorage.GetPostRevisions(ctx, permlink)
+	assert.Nil(t, err)
+	for _, revision := range revisions {
+		assert.Equal(t, "", revision.Title)
+		assert.Equal(t, "", revision.Content)
+		assert.NotEqual(t, "", revision.Hash)
+	}
+}
+
 func TestDeletePost(t *testing.T) {
 	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
 	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
@@ -63,6 +63,36 @@ func ErrFailedToMarshalPostView(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalPostView, fmt.Sprintf("failed to marshal post view: %s", err.Error()))
 }
 
+// ErrPostRevisionNotFound - error if post revision is not found in KVStore
+func ErrPostRevisionNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostRevisionNotFound, fmt.Sprintf("Post revision not found for key: %s", key))
+}
+
+// ErrFailedToMarshalPostRevision - error if marshal post revision failed
+func ErrFailedToMarshalPostRevision(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostRevision, fmt.Sprintf("failed to marshal post revision: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostRevision - error if unmarshal post revision failed
+func ErrFailedToUnmarshalPostRevision(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostRevision, fmt.Sprintf("failed to unmarshal post revision: %s", err.Error()))
+}
+
+// ErrSubscriptionNotFound - error if subscription is not found in KVStore
+func ErrSubscriptionNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodeSubscriptionNotFound, fmt.Sprintf("Subscription not found for key: %s", key))
+}
+
+// ErrFailedToMarshalSubscription - error if marshal subscription failed
+func ErrFailedToMarshalSubscription(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalSubscription, fmt.Sprintf("failed to marshal subscription: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalSubscription - error if unmarshal subscription failed
+func ErrFailedToUnmarshalSubscription(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalSubscription, fmt.Sprintf("failed to unmarshal subscription: %s", err.Error()))
+}
+
 // ErrFailedToMarshalPostDonations - error if marshal post donation failed
 func ErrFailedToMarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalPostDonations, fmt.Sprintf("failed to marshal post donations: %s", err.Error()))
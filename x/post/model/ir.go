@@ -28,6 +28,9 @@ type PostRowIR struct {
 
 // PostTablesIR - PostRow changed.
 type PostTablesIR struct {
-	Posts     []PostRowIR   `json:"posts"`
-	PostUsers []PostUserRow `json:"post_users"`
+	Posts         []PostRowIR       `json:"posts"`
+	PostUsers     []PostUserRow     `json:"post_users"`
+	PostDonations []PostDonationRow `json:"post_donations"`
+	PostRevisions []PostRevisionRow `json:"post_revisions"`
+	Subscriptions []Subscription    `json:"subscriptions"`
 }
@@ -1,6 +1,10 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
 	"github.com/lino-network/lino/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +27,8 @@ type PostInfo struct {
 	SourceAuthor types.AccountKey       `json:"source_author"`
 	SourcePostID string                 `json:"source_postID"`
 	Links        []types.IDToURLMapping `json:"links"`
+	App          types.AccountKey       `json:"app"`
+	Tags         []string               `json:"tags"`
 }
 
 // PostMeta - stores tiny and frequently updated fields.
@@ -78,3 +84,124 @@ type View struct {
 	LastViewAt int64            `json:"last_view_at"`
 	Times      int64            `jons:"times"`
 }
+
+// Donation - donation from a user to a post, with the app it comes through.
+// AppVerified is true if the app co-signed the donation.
+type Donation struct {
+	Username    types.AccountKey `json:"username"`
+	Amount      types.Coin       `json:"amount"`
+	FromApp     types.AccountKey `json:"from_app"`
+	AppVerified bool             `json:"app_verified"`
+	CreatedAt   int64            `json:"created_at"`
+}
+
+// PostRevision - a replaced version of post title, content and links.
+// A post starts at revision 1 and each update creates a new revision.
+// Hash is always kept, title, content and links are kept only if the author asked for it,
+// and are cleared when the post is deleted.
+type PostRevision struct {
+	Revision   int64                  `json:"revision"`
+	CreatedAt  int64                  `json:"created_at"`
+	ReplacedAt int64                  `json:"replaced_at"`
+	Hash       string                 `json:"hash"`
+	Title      string                 `json:"title"`
+	Content    string                 `json:"content"`
+	Links      []types.IDToURLMapping `json:"links"`
+}
+
+// Subscription - recurring donation from a subscriber to a post.
+// A payment is made every IntervalSec from CreatedAt, until Periods payments are
+// paid or missed. A payment is missed if the subscriber can't afford it or the post
+// is deleted. A cancelled subscription is removed when its next payment is due.
+// AppVerified is true if FromApp co-signed the subscription.
+type Subscription struct {
+	Subscriber    types.AccountKey `json:"subscriber"`
+	Author        types.AccountKey `json:"author"`
+	PostID        string           `json:"post_id"`
+	Amount        types.Coin       `json:"amount"`
+	FromApp       types.AccountKey `json:"from_app"`
+	AppVerified   bool             `json:"app_verified"`
+	Memo          string           `json:"memo"`
+	IntervalSec   int64            `json:"interval_sec"`
+	Periods       int64            `json:"periods"`
+	PaidPeriods   int64            `json:"paid_periods"`
+	MissedPeriods int64            `json:"missed_periods"`
+	CreatedAt     int64            `json:"created_at"`
+	NextPaymentAt int64            `json:"next_payment_at"`
+	IsCancelled   bool             `json:"is_cancelled"`
+}
+
+// GetPostContentHash - hex encoded SHA-256 of post title, content and links,
+// each string is prefixed by its uvarint encoded length.
+func GetPostContentHash(title, content string, links []types.IDToURLMapping) string {
+	h := sha256.New()
+	write := func(s string) {
+		lenBytes := make([]byte, binary.MaxVarintLen64)
+		n := binary.PutUvarint(lenBytes, uint64(len(s)))
+		h.Write(lenBytes[:n])
+		h.Write([]byte(s))
+	}
+	write(title)
+	write(content)
+	for _, link := range links {
+		write(link.Identifier)
+		write(link.URL)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// PostInfoPage - a page of posts of an author, in post ID order
+// Next - post ID to query next page from, empty if there is no more post
+type PostInfoPage struct {
+	Posts []PostInfo `json:"posts"`
+	Next  string     `json:"next"`
+}
+
+// CommentPage - a page of comments to a post, in comment permlink order
+// Next - comment permlink to query next page from, empty if there is no more comment
+type CommentPage struct {
+	Comments []Comment `json:"comments"`
+	Next     string    `json:"next"`
+}
+
+// ViewPage - a page of views to a post, in username order
+// Next - username to query next page from, empty if there is no more view
+type ViewPage struct {
+	Views []View `json:"views"`
+	Next  string `json:"next"`
+}
+
+// ReportOrUpvotePage - a page of reports or upvotes to a post, in username order
+// Next - username to query next page from, empty if there is no more report or upvote
+type ReportOrUpvotePage struct {
+	ReportOrUpvotes []ReportOrUpvote `json:"report_or_upvotes"`
+	Next            string           `json:"next"`
+}
+
+// DonationPage - a page of donation records of a post, in time order
+// Next - sequence to query next page from, empty if there is no more donation
+type DonationPage struct {
+	Donations []Donation `json:"donations"`
+	Next      string     `json:"next"`
+}
+
+// RevisionPage - a page of revision log of a post, from the oldest to the latest
+// Next - revision to query next page from, empty if there is no more revision
+type RevisionPage struct {
+	Revisions []PostRevision `json:"revisions"`
+	Next      string         `json:"next"`
+}
+
+// SubscriptionPage - a page of subscriptions of a subscriber, in permlink order
+// Next - permlink to query next page from, empty if there is no more subscription
+type SubscriptionPage struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+	Next          string         `json:"next"`
+}
+
+// TagPostPage - a page of posts with a tag, in creation order
+// Next - cursor to query next page from, empty if there is no more post
+type TagPostPage struct {
+	Posts []PostInfo `json:"posts"`
+	Next  string     `json:"next"`
+}
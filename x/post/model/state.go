@@ -4,6 +4,15 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// table names of post storage, used in streaming export and import.
+const (
+	PostTable         = "posts"
+	PostUserTable     = "post_users"
+	PostDonationTable = "post_donations"
+	PostRevisionTable = "post_revisions"
+	SubscriptionTable = "subscriptions"
+)
+
 // PostRow - pk: permlink
 type PostRow struct {
 	Permlink types.Permlink `json:"permlink"`
@@ -30,6 +39,19 @@ type PostUserRow struct {
 	// Donations      Donations        `json:"donations"`
 }
 
+// PostDonationRow - pk: (permlink, seq)
+type PostDonationRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Seq      int64          `json:"seq"`
+	Donation Donation       `json:"donation"`
+}
+
+// PostRevisionRow - pk: (permlink, revision)
+type PostRevisionRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Revision PostRevision   `json:"revision"`
+}
+
 // XXX(yumin): not exported for upgrade-1
 // PostCommentRow - pk: (permlink, commentPermlink)
 // type PostCommentRow struct {
@@ -40,8 +62,11 @@ type PostUserRow struct {
 
 // PostTables - state of post store.
 type PostTables struct {
-	Posts     []PostRow     `json:"posts"`
-	PostUsers []PostUserRow `json:"post_users"`
+	Posts         []PostRow         `json:"posts"`
+	PostUsers     []PostUserRow     `json:"post_users"`
+	PostDonations []PostDonationRow `json:"post_donations"`
+	PostRevisions []PostRevisionRow `json:"post_revisions"`
+	Subscriptions []Subscription    `json:"subscriptions"`
 	// not exported for upgrade-1
 	// PostComments []PostCommentRow `json:"post_comments"`
 }
@@ -53,5 +78,8 @@ func (p PostTables) ToIR() *PostTablesIR {
 		rst.Posts = append(rst.Posts, v.ToIR())
 	}
 	rst.PostUsers = p.PostUsers
+	rst.PostDonations = p.PostDonations
+	rst.PostRevisions = p.PostRevisions
+	rst.Subscriptions = p.Subscriptions
 	return rst
 }
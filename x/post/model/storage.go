@@ -1,6 +1,9 @@
 package model
 
 import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
 	"strings"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +20,10 @@ var (
 	postViewsSubStore          = []byte{0x04} // SubStore for all views
 	// XXX(yukai): deprecated.
 	// postDonationsSubStore      = []byte{0x05} // SubStore for all donations
+	postDonationSubStore = []byte{0x06} // SubStore for all donation records
+	postTagSubStore      = []byte{0x07} // SubStore for tag to post index
+	postRevisionSubStore = []byte{0x08} // SubStore for post revision log
+	subscriptionSubStore = []byte{0x09} // SubStore for subscriptions
 )
 
 // PostStorage - post storage
@@ -178,60 +185,533 @@ func (ps PostStorage) SetPostView(ctx sdk.Context, permlink types.Permlink, post
 	return nil
 }
 
-// Export post storage state.
-func (ps PostStorage) Export(ctx sdk.Context) *PostTables {
-	tables := &PostTables{}
+// AddPostDonation - append a donation record to the post.
+func (ps PostStorage) AddPostDonation(
+	ctx sdk.Context, permlink types.Permlink, donation *Donation) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	seq := int64(0)
+	prefix := getPostDonationPrefix(permlink)
+	itr := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if itr.Valid() {
+		seq = int64(binary.BigEndian.Uint64(itr.Key()[len(prefix):])) + 1
+	}
+	itr.Close()
+	return ps.SetPostDonation(ctx, permlink, seq, donation)
+}
+
+// SetPostDonation - set donation record of the post at @p seq.
+func (ps PostStorage) SetPostDonation(
+	ctx sdk.Context, permlink types.Permlink, seq int64, donation *Donation) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	donationByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*donation)
+	if err != nil {
+		return ErrFailedToMarshalPostDonations(err)
+	}
+	store.Set(getPostDonationKey(permlink, seq), donationByte)
+	return nil
+}
+
+// GetPostDonations - returns donation records of the post, in time order.
+func (ps PostStorage) GetPostDonations(ctx sdk.Context, permlink types.Permlink) ([]Donation, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getPostDonationPrefix(permlink))
+	defer itr.Close()
+	donations := []Donation{}
+	for ; itr.Valid(); itr.Next() {
+		donation := Donation{}
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &donation); err != nil {
+			return nil, ErrFailedToUnmarshalPostDonations(err)
+		}
+		donations = append(donations, donation)
+	}
+	return donations, nil
+}
+
+// AddPostRevision - append @p revision to the revision log of the post,
+// drop the oldest revisions if there are more than MaximumNumOfPostRevisions.
+func (ps PostStorage) AddPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revision *PostRevision) sdk.Error {
+	if err := ps.SetPostRevision(ctx, permlink, revision); err != nil {
+		return err
+	}
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStoreReversePrefixIterator(store, getPostRevisionPrefix(permlink))
+	defer itr.Close()
+	outdated := [][]byte{}
+	for n := 0; itr.Valid(); itr.Next() {
+		if n >= types.MaximumNumOfPostRevisions {
+			outdated = append(outdated, itr.Key())
+		}
+		n++
+	}
+	for _, key := range outdated {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// SetPostRevision - set revision log entry of the post.
+func (ps PostStorage) SetPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revision *PostRevision) sdk.Error {
 	store := ctx.KVStore(ps.key)
-	// export table.Posts
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, postInfoSubStore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			permlink := types.Permlink(k[1:])
-			info, err := ps.GetPostInfo(ctx, permlink)
+	revisionByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*revision)
+	if err != nil {
+		return ErrFailedToMarshalPostRevision(err)
+	}
+	store.Set(getPostRevisionKey(permlink, revision.Revision), revisionByte)
+	return nil
+}
+
+// GetPostRevision - get revision log entry of the post.
+func (ps PostStorage) GetPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revision int64) (*PostRevision, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	key := getPostRevisionKey(permlink, revision)
+	revisionByte := store.Get(key)
+	if revisionByte == nil {
+		return nil, ErrPostRevisionNotFound(key)
+	}
+	postRevision := new(PostRevision)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(revisionByte, postRevision); err != nil {
+		return nil, ErrFailedToUnmarshalPostRevision(err)
+	}
+	return postRevision, nil
+}
+
+// GetPostRevisions - returns revision log of the post, from the oldest to the latest.
+func (ps PostStorage) GetPostRevisions(ctx sdk.Context, permlink types.Permlink) ([]PostRevision, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getPostRevisionPrefix(permlink))
+	defer itr.Close()
+	revisions := []PostRevision{}
+	for ; itr.Valid(); itr.Next() {
+		revision := PostRevision{}
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &revision); err != nil {
+			return nil, ErrFailedToUnmarshalPostRevision(err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// GetCurrentPostRevision - revision number of the current post content,
+// which is the next of the latest revision in the log.
+func (ps PostStorage) GetCurrentPostRevision(ctx sdk.Context, permlink types.Permlink) int64 {
+	store := ctx.KVStore(ps.key)
+	prefix := getPostRevisionPrefix(permlink)
+	itr := sdk.KVStoreReversePrefixIterator(store, prefix)
+	defer itr.Close()
+	if !itr.Valid() {
+		return 1
+	}
+	return int64(binary.BigEndian.Uint64(itr.Key()[len(prefix):])) + 1
+}
+
+// DoesSubscriptionExist - check if @p subscriber has a subscription to the post.
+func (ps PostStorage) DoesSubscriptionExist(
+	ctx sdk.Context, subscriber types.AccountKey, permlink types.Permlink) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getSubscriptionKey(subscriber, permlink))
+}
+
+// GetSubscription - get subscription of @p subscriber to the post.
+func (ps PostStorage) GetSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, permlink types.Permlink) (*Subscription, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	key := getSubscriptionKey(subscriber, permlink)
+	subscriptionByte := store.Get(key)
+	if subscriptionByte == nil {
+		return nil, ErrSubscriptionNotFound(key)
+	}
+	subscription := new(Subscription)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(subscriptionByte, subscription); err != nil {
+		return nil, ErrFailedToUnmarshalSubscription(err)
+	}
+	return subscription, nil
+}
+
+// SetSubscription - set subscription, keyed by subscriber and post.
+func (ps PostStorage) SetSubscription(ctx sdk.Context, subscription *Subscription) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	subscriptionByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*subscription)
+	if err != nil {
+		return ErrFailedToMarshalSubscription(err)
+	}
+	permlink := types.GetPermlink(subscription.Author, subscription.PostID)
+	store.Set(getSubscriptionKey(subscription.Subscriber, permlink), subscriptionByte)
+	return nil
+}
+
+// DeleteSubscription - remove subscription of @p subscriber to the post.
+func (ps PostStorage) DeleteSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getSubscriptionKey(subscriber, permlink))
+}
+
+func (ps PostStorage) iterateSubscriptions(
+	ctx sdk.Context, prefix []byte, process func(Subscription)) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, prefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		subscription := Subscription{}
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &subscription); err != nil {
+			return ErrFailedToUnmarshalSubscription(err)
+		}
+		process(subscription)
+	}
+	return nil
+}
+
+// SetPostTag - add post to the index of @p tag, posts with the same tag are ordered by
+// creation time @p createdAt.
+func (ps PostStorage) SetPostTag(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(getPostTagKey(tag, createdAt, permlink), []byte(permlink))
+}
+
+// DeletePostTag - remove post from the index of @p tag.
+func (ps PostStorage) DeletePostTag(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostTagKey(tag, createdAt, permlink))
+}
+
+// GetTagPostPage - get at most @p limit posts with @p tag in creation order,
+// starting from cursor @p start, which is the decoded next of the previous page.
+// Next of returned page is hex encoded.
+func (ps PostStorage) GetTagPostPage(
+	ctx sdk.Context, tag string, start []byte, limit int64) (*TagPostPage, sdk.Error) {
+	page := &TagPostPage{Posts: []PostInfo{}}
+	next, err := ps.iteratePage(
+		ctx, getPostTagPrefix(tag), start, limit, func(value []byte) sdk.Error {
+			postInfo, err := ps.GetPostInfo(ctx, types.Permlink(value))
 			if err != nil {
-				panic("failed to read post info: " + err.Error())
+				return err
 			}
-			meta, err := ps.GetPostMeta(ctx, permlink)
-			if err != nil {
-				panic("failed to read post meta: " + err.Error())
+			page.Posts = append(page.Posts, *postInfo)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	page.Next = hex.EncodeToString(next)
+	return page, nil
+}
+
+// GetPostInfoPage - get at most @p limit posts of @p author, starting from post ID @p start.
+func (ps PostStorage) GetPostInfoPage(
+	ctx sdk.Context, author types.AccountKey, start string, limit int64) (*PostInfoPage, sdk.Error) {
+	page := &PostInfoPage{Posts: []PostInfo{}}
+	prefix := append(GetPostInfoPrefix(author), types.PermlinkSeparator...)
+	next, err := ps.iteratePage(ctx, prefix, []byte(start), limit, func(value []byte) sdk.Error {
+		postInfo := PostInfo{}
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &postInfo); err != nil {
+			return ErrFailedToUnmarshalPostInfo(err)
+		}
+		page.Posts = append(page.Posts, postInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	page.Next = string(next)
+	return page, nil
+}
+
+// GetPostCommentPage - get at most @p limit comments of the post,
+// starting from comment permlink @p start.
+func (ps PostStorage) GetPostCommentPage(
+	ctx sdk.Context, permlink types.Permlink, start types.Permlink, limit int64) (*CommentPage, sdk.Error) {
+	page := &CommentPage{Comments: []Comment{}}
+	next, err := ps.iteratePage(
+		ctx, getPostCommentPrefix(permlink), []byte(start), limit, func(value []byte) sdk.Error {
+			comment := Comment{}
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &comment); err != nil {
+				return ErrFailedToUnmarshalPostComment(err)
 			}
-			row := PostRow{
-				Permlink: permlink,
-				Info:     *info,
-				Meta:     *meta,
+			page.Comments = append(page.Comments, comment)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	page.Next = string(next)
+	return page, nil
+}
+
+// GetPostViewPage - get at most @p limit views of the post, starting from username @p start.
+func (ps PostStorage) GetPostViewPage(
+	ctx sdk.Context, permlink types.Permlink, start types.AccountKey, limit int64) (*ViewPage, sdk.Error) {
+	page := &ViewPage{Views: []View{}}
+	next, err := ps.iteratePage(
+		ctx, getPostViewPrefix(permlink), []byte(start), limit, func(value []byte) sdk.Error {
+			view := View{}
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &view); err != nil {
+				return ErrFailedToUnmarshalPostView(err)
 			}
-			tables.Posts = append(tables.Posts, row)
-		}
-	}()
-	// export tables.PostUser
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, postReportOrUpvoteSubStore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			permlinkAccount := string(k[1:])
-			strs := strings.Split(permlinkAccount, types.KeySeparator)
-			if len(strs) != 2 {
-				panic("failed to split out permlink account: " + permlinkAccount)
+			page.Views = append(page.Views, view)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	page.Next = string(next)
+	return page, nil
+}
+
+// GetPostReportOrUpvotePage - get at most @p limit reports or upvotes of the post,
+// starting from username @p start.
+func (ps PostStorage) GetPostReportOrUpvotePage(
+	ctx sdk.Context, permlink types.Permlink, start types.AccountKey, limit int64) (*ReportOrUpvotePage, sdk.Error) {
+	page := &ReportOrUpvotePage{ReportOrUpvotes: []ReportOrUpvote{}}
+	next, err := ps.iteratePage(
+		ctx, getPostReportOrUpvotePrefix(permlink), []byte(start), limit, func(value []byte) sdk.Error {
+			reportOrUpvote := ReportOrUpvote{}
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &reportOrUpvote); err != nil {
+				return ErrFailedToUnmarshalPostReportOrUpvote(err)
 			}
-			permlink, username := types.Permlink(strs[0]), types.AccountKey(strs[1])
-			ru, err := ps.GetPostReportOrUpvote(ctx, permlink, username)
-			if err != nil {
-				panic("failed to get report or upvote: " + err.Error())
+			page.ReportOrUpvotes = append(page.ReportOrUpvotes, reportOrUpvote)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	page.Next = string(next)
+	return page, nil
+}
+
+// GetPostDonationPage - get at most @p limit donation records of the post,
+// starting from sequence @p start.
+func (ps PostStorage) GetPostDonationPage(
+	ctx sdk.Context, permlink types.Permlink, start, limit int64) (*DonationPage, sdk.Error) {
+	page := &DonationPage{Donations: []Donation{}}
+	startBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(startBytes, uint64(start))
+	next, err := ps.iteratePage(
+		ctx, getPostDonationPrefix(permlink), startBytes, limit, func(value []byte) sdk.Error {
+			donation := Donation{}
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &donation); err != nil {
+				return ErrFailedToUnmarshalPostDonations(err)
 			}
-			row := PostUserRow{
-				Permlink:       permlink,
-				User:           username,
-				ReportOrUpvote: *ru,
+			page.Donations = append(page.Donations, donation)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		page.Next = strconv.FormatUint(binary.BigEndian.Uint64(next), 10)
+	}
+	return page, nil
+}
+
+// GetPostRevisionPage - get at most @p limit revisions of the post,
+// starting from revision @p start.
+func (ps PostStorage) GetPostRevisionPage(
+	ctx sdk.Context, permlink types.Permlink, start, limit int64) (*RevisionPage, sdk.Error) {
+	page := &RevisionPage{Revisions: []PostRevision{}}
+	startBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(startBytes, uint64(start))
+	next, err := ps.iteratePage(
+		ctx, getPostRevisionPrefix(permlink), startBytes, limit, func(value []byte) sdk.Error {
+			revision := PostRevision{}
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &revision); err != nil {
+				return ErrFailedToUnmarshalPostRevision(err)
 			}
-			tables.PostUsers = append(tables.PostUsers, row)
+			page.Revisions = append(page.Revisions, revision)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		page.Next = strconv.FormatUint(binary.BigEndian.Uint64(next), 10)
+	}
+	return page, nil
+}
+
+// GetSubscriptionPage - get at most @p limit subscriptions of @p subscriber,
+// starting from permlink @p start.
+func (ps PostStorage) GetSubscriptionPage(
+	ctx sdk.Context, subscriber types.AccountKey, start types.Permlink, limit int64) (*SubscriptionPage, sdk.Error) {
+	page := &SubscriptionPage{Subscriptions: []Subscription{}}
+	next, err := ps.iteratePage(
+		ctx, getSubscriptionPrefix(subscriber), []byte(start), limit, func(value []byte) sdk.Error {
+			subscription := Subscription{}
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(value, &subscription); err != nil {
+				return ErrFailedToUnmarshalSubscription(err)
+			}
+			page.Subscriptions = append(page.Subscriptions, subscription)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	page.Next = string(next)
+	return page, nil
+}
+
+// iteratePage - process values of at most @p limit keys under @p prefix in key order,
+// starting from key prefix + @p start. Returns the key suffix after prefix of the first
+// key not processed, nil if all keys are processed.
+func (ps PostStorage) iteratePage(
+	ctx sdk.Context, prefix, start []byte, limit int64, process func(value []byte) sdk.Error) ([]byte, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	startKey := append(append([]byte{}, prefix...), start...)
+	itr := store.Iterator(startKey, sdk.PrefixEndBytes(prefix))
+	defer itr.Close()
+	for n := int64(0); itr.Valid(); itr.Next() {
+		if n >= limit {
+			return append([]byte{}, itr.Key()[len(prefix):]...), nil
+		}
+		if err := process(itr.Value()); err != nil {
+			return nil, err
 		}
-	}()
+		n++
+	}
+	return nil, nil
+}
+
+// Export post storage state.
+func (ps PostStorage) Export(ctx sdk.Context) *PostTables {
+	tables := &PostTables{}
+	ps.iteratePostRows(ctx, func(row PostRow) {
+		tables.Posts = append(tables.Posts, row)
+	})
+	ps.iteratePostUserRows(ctx, func(row PostUserRow) {
+		tables.PostUsers = append(tables.PostUsers, row)
+	})
+	ps.iteratePostDonationRows(ctx, func(row PostDonationRow) {
+		tables.PostDonations = append(tables.PostDonations, row)
+	})
+	ps.iteratePostRevisionRows(ctx, func(row PostRevisionRow) {
+		tables.PostRevisions = append(tables.PostRevisions, row)
+	})
+	if err := ps.iterateSubscriptions(ctx, subscriptionSubStore, func(row Subscription) {
+		tables.Subscriptions = append(tables.Subscriptions, row)
+	}); err != nil {
+		panic("failed to read subscription: " + err.Error())
+	}
 	return tables
 }
 
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (ps PostStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	ps.iteratePostRows(ctx, func(row PostRow) {
+		write(PostTable, row.ToIR())
+	})
+	ps.iteratePostUserRows(ctx, func(row PostUserRow) {
+		write(PostUserTable, row)
+	})
+	ps.iteratePostDonationRows(ctx, func(row PostDonationRow) {
+		write(PostDonationTable, row)
+	})
+	ps.iteratePostRevisionRows(ctx, func(row PostRevisionRow) {
+		write(PostRevisionTable, row)
+	})
+	if err := ps.iterateSubscriptions(ctx, subscriptionSubStore, func(row Subscription) {
+		write(SubscriptionTable, row)
+	}); err != nil {
+		panic("failed to read subscription: " + err.Error())
+	}
+}
+
+func (ps PostStorage) iteratePostRows(ctx sdk.Context, process func(PostRow)) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, postInfoSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		permlink := types.Permlink(k[1:])
+		info, err := ps.GetPostInfo(ctx, permlink)
+		if err != nil {
+			panic("failed to read post info: " + err.Error())
+		}
+		meta, err := ps.GetPostMeta(ctx, permlink)
+		if err != nil {
+			panic("failed to read post meta: " + err.Error())
+		}
+		process(PostRow{
+			Permlink: permlink,
+			Info:     *info,
+			Meta:     *meta,
+		})
+	}
+}
+
+func (ps PostStorage) iteratePostUserRows(ctx sdk.Context, process func(PostUserRow)) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, postReportOrUpvoteSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		permlinkAccount := string(k[1:])
+		strs := strings.Split(permlinkAccount, types.KeySeparator)
+		if len(strs) != 2 {
+			panic("failed to split out permlink account: " + permlinkAccount)
+		}
+		permlink, username := types.Permlink(strs[0]), types.AccountKey(strs[1])
+		ru, err := ps.GetPostReportOrUpvote(ctx, permlink, username)
+		if err != nil {
+			panic("failed to get report or upvote: " + err.Error())
+		}
+		process(PostUserRow{
+			Permlink:       permlink,
+			User:           username,
+			ReportOrUpvote: *ru,
+		})
+	}
+}
+
+func (ps PostStorage) iteratePostDonationRows(ctx sdk.Context, process func(PostDonationRow)) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, postDonationSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		// key is substore + permlink + separator + 8 bytes seq.
+		if len(k) < len(postDonationSubStore)+len(types.KeySeparator)+8 {
+			panic("failed to split out permlink seq: " + string(k))
+		}
+		permlink := types.Permlink(k[len(postDonationSubStore) : len(k)-8-len(types.KeySeparator)])
+		seq := int64(binary.BigEndian.Uint64(k[len(k)-8:]))
+		donation := Donation{}
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &donation); err != nil {
+			panic("failed to read post donation: " + err.Error())
+		}
+		process(PostDonationRow{
+			Permlink: permlink,
+			Seq:      seq,
+			Donation: donation,
+		})
+	}
+}
+
+func (ps PostStorage) iteratePostRevisionRows(ctx sdk.Context, process func(PostRevisionRow)) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, postRevisionSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		// key is substore + permlink + separator + 8 bytes revision.
+		if len(k) < len(postRevisionSubStore)+len(types.KeySeparator)+8 {
+			panic("failed to split out permlink revision: " + string(k))
+		}
+		permlink := types.Permlink(k[len(postRevisionSubStore) : len(k)-8-len(types.KeySeparator)])
+		revision := PostRevision{}
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &revision); err != nil {
+			panic("failed to read post revision: " + err.Error())
+		}
+		process(PostRevisionRow{
+			Permlink: permlink,
+			Revision: revision,
+		})
+	}
+}
+
 // Import from tablesIR.
 func (ps PostStorage) Import(ctx sdk.Context, tb *PostTablesIR) {
 	check := func(e error) {
@@ -241,28 +721,89 @@ func (ps PostStorage) Import(ctx sdk.Context, tb *PostTablesIR) {
 	}
 	// import table.developers
 	for _, v := range tb.Posts {
-		err := ps.SetPostInfo(ctx, &v.Info)
-		check(err)
-		err = ps.SetPostMeta(ctx, v.Permlink, &PostMeta{
-			CreatedAt:               v.Meta.CreatedAt,
-			LastUpdatedAt:           v.Meta.LastUpdatedAt,
-			LastActivityAt:          v.Meta.LastActivityAt,
-			AllowReplies:            v.Meta.AllowReplies,
-			IsDeleted:               v.Meta.IsDeleted,
-			TotalDonateCount:        v.Meta.TotalDonateCount,
-			TotalReportCoinDay:      v.Meta.TotalReportCoinDay,
-			TotalUpvoteCoinDay:      v.Meta.TotalUpvoteCoinDay,
-			TotalViewCount:          v.Meta.TotalViewCount,
-			TotalReward:             v.Meta.TotalReward,
-			RedistributionSplitRate: sdk.MustNewDecFromStr(v.Meta.RedistributionSplitRate),
-		})
-		check(err)
+		ps.importPostRow(ctx, v)
 	}
 	// import PostUsers
 	for _, v := range tb.PostUsers {
 		err := ps.SetPostReportOrUpvote(ctx, v.Permlink, &v.ReportOrUpvote)
 		check(err)
 	}
+	// import PostDonations
+	for _, v := range tb.PostDonations {
+		err := ps.SetPostDonation(ctx, v.Permlink, v.Seq, &v.Donation)
+		check(err)
+	}
+	// import PostRevisions
+	for _, v := range tb.PostRevisions {
+		err := ps.SetPostRevision(ctx, v.Permlink, &v.Revision)
+		check(err)
+	}
+	// import Subscriptions
+	for _, v := range tb.Subscriptions {
+		err := ps.SetSubscription(ctx, &v)
+		check(err)
+	}
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (ps PostStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[ps] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case PostTable:
+		row := PostRowIR{}
+		check(read(&row))
+		ps.importPostRow(ctx, row)
+	case PostUserTable:
+		row := PostUserRow{}
+		check(read(&row))
+		check(ps.SetPostReportOrUpvote(ctx, row.Permlink, &row.ReportOrUpvote))
+	case PostDonationTable:
+		row := PostDonationRow{}
+		check(read(&row))
+		check(ps.SetPostDonation(ctx, row.Permlink, row.Seq, &row.Donation))
+	case PostRevisionTable:
+		row := PostRevisionRow{}
+		check(read(&row))
+		check(ps.SetPostRevision(ctx, row.Permlink, &row.Revision))
+	case SubscriptionTable:
+		row := Subscription{}
+		check(read(&row))
+		check(ps.SetSubscription(ctx, &row))
+	default:
+		panic("[ps] Failed to import: unknown table " + table)
+	}
+}
+
+func (ps PostStorage) importPostRow(ctx sdk.Context, v PostRowIR) {
+	check := func(e error) {
+		if e != nil {
+			panic("[ps] Failed to import: " + e.Error())
+		}
+	}
+	err := ps.SetPostInfo(ctx, &v.Info)
+	check(err)
+	err = ps.SetPostMeta(ctx, v.Permlink, &PostMeta{
+		CreatedAt:               v.Meta.CreatedAt,
+		LastUpdatedAt:           v.Meta.LastUpdatedAt,
+		LastActivityAt:          v.Meta.LastActivityAt,
+		AllowReplies:            v.Meta.AllowReplies,
+		IsDeleted:               v.Meta.IsDeleted,
+		TotalDonateCount:        v.Meta.TotalDonateCount,
+		TotalReportCoinDay:      v.Meta.TotalReportCoinDay,
+		TotalUpvoteCoinDay:      v.Meta.TotalUpvoteCoinDay,
+		TotalViewCount:          v.Meta.TotalViewCount,
+		TotalReward:             v.Meta.TotalReward,
+		RedistributionSplitRate: sdk.MustNewDecFromStr(v.Meta.RedistributionSplitRate),
+	})
+	check(err)
+	// tag index is not exported, rebuild it from post info.
+	for _, tag := range v.Info.Tags {
+		ps.SetPostTag(ctx, tag, v.Meta.CreatedAt, v.Permlink)
+	}
 }
 
 // GetPostInfoPrefix - "post info substore" + "author"
@@ -312,3 +853,53 @@ func getPostCommentPrefix(permlink types.Permlink) []byte {
 func getPostCommentKey(permlink types.Permlink, commentPermlink types.Permlink) []byte {
 	return append(getPostCommentPrefix(permlink), commentPermlink...)
 }
+
+// getPostDonationPrefix - "post donation substore" + "permlink"
+// which can be used to access all donation records belong to this post
+func getPostDonationPrefix(permlink types.Permlink) []byte {
+	return append(append(postDonationSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostDonationKey - "post donation substore" + "permlink" + big endian seq
+func getPostDonationKey(permlink types.Permlink, seq int64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, uint64(seq))
+	return append(getPostDonationPrefix(permlink), seqBytes...)
+}
+
+// getPostRevisionPrefix - "post revision substore" + "permlink"
+// which can be used to access all revisions of the post
+func getPostRevisionPrefix(permlink types.Permlink) []byte {
+	return append(append(postRevisionSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostRevisionKey - "post revision substore" + "permlink" + big endian revision
+func getPostRevisionKey(permlink types.Permlink, revision int64) []byte {
+	revisionBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(revisionBytes, uint64(revision))
+	return append(getPostRevisionPrefix(permlink), revisionBytes...)
+}
+
+// getSubscriptionPrefix - "subscription substore" + "subscriber"
+// which can be used to access all subscriptions of the subscriber
+func getSubscriptionPrefix(subscriber types.AccountKey) []byte {
+	return append(append(subscriptionSubStore, subscriber...), types.KeySeparator...)
+}
+
+// getSubscriptionKey - "subscription substore" + "subscriber" + "permlink"
+func getSubscriptionKey(subscriber types.AccountKey, permlink types.Permlink) []byte {
+	return append(getSubscriptionPrefix(subscriber), permlink...)
+}
+
+// getPostTagPrefix - "post tag substore" + "tag"
+// which can be used to access all posts with this tag
+func getPostTagPrefix(tag string) []byte {
+	return append(append(postTagSubStore, tag...), types.KeySeparator...)
+}
+
+// getPostTagKey - "post tag substore" + "tag" + big endian created at + "permlink"
+func getPostTagKey(tag string, createdAt int64, permlink types.Permlink) []byte {
+	createdAtBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(createdAtBytes, uint64(createdAt))
+	return append(append(getPostTagPrefix(tag), createdAtBytes...), permlink...)
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store"
@@ -110,6 +111,159 @@ func TestPostView(t *testing.T) {
 	})
 }
 
+func TestPostDonation(t *testing.T) {
+	donations := []Donation{
+		{Username: "user1", Amount: types.NewCoinFromInt64(1), FromApp: "app", AppVerified: true, CreatedAt: 100},
+		{Username: "user2", Amount: types.NewCoinFromInt64(2), CreatedAt: 200},
+	}
+
+	runTest(t, func(env TestEnv) {
+		for i := range donations {
+			err := env.ps.AddPostDonation(env.ctx, types.Permlink("test"), &donations[i])
+			assert.Nil(t, err)
+		}
+		err := env.ps.AddPostDonation(env.ctx, types.Permlink("other"), &donations[0])
+		assert.Nil(t, err)
+
+		result, err := env.ps.GetPostDonations(env.ctx, types.Permlink("test"))
+		assert.Nil(t, err)
+		assert.Equal(t, donations, result, "Post donations should be equal")
+
+		rows := []PostDonationRow{}
+		env.ps.iteratePostDonationRows(env.ctx, func(row PostDonationRow) {
+			rows = append(rows, row)
+		})
+		assert.Equal(t, []PostDonationRow{
+			{Permlink: "other", Seq: 0, Donation: donations[0]},
+			{Permlink: "test", Seq: 0, Donation: donations[0]},
+			{Permlink: "test", Seq: 1, Donation: donations[1]},
+		}, rows)
+	})
+}
+
+func TestPostPages(t *testing.T) {
+	permlink := types.Permlink("author#post")
+	runTest(t, func(env TestEnv) {
+		posts := []PostInfo{}
+		for _, postID := range []string{"p1", "p2", "p3"} {
+			postInfo := PostInfo{PostID: postID, Author: "author", Title: postID}
+			assert.Nil(t, env.ps.SetPostInfo(env.ctx, &postInfo))
+			posts = append(posts, postInfo)
+		}
+		// posts of author with the same prefix are not included
+		assert.Nil(t, env.ps.SetPostInfo(env.ctx, &PostInfo{PostID: "p1", Author: "author2"}))
+
+		postPage, err := env.ps.GetPostInfoPage(env.ctx, "author", "", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &PostInfoPage{Posts: posts[:2], Next: "p3"}, postPage)
+		postPage, err = env.ps.GetPostInfoPage(env.ctx, "author", postPage.Next, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &PostInfoPage{Posts: posts[2:], Next: ""}, postPage)
+
+		comments := []Comment{}
+		views := []View{}
+		reportOrUpvotes := []ReportOrUpvote{}
+		donations := []Donation{}
+		for i, user := range []types.AccountKey{"user1", "user2", "user3"} {
+			comment := Comment{Author: user, PostID: "comment", CreatedAt: int64(i)}
+			assert.Nil(t, env.ps.SetPostComment(env.ctx, permlink, &comment))
+			comments = append(comments, comment)
+			view := View{Username: user, LastViewAt: int64(i), Times: 1}
+			assert.Nil(t, env.ps.SetPostView(env.ctx, permlink, &view))
+			views = append(views, view)
+			reportOrUpvote := ReportOrUpvote{
+				Username: user, CoinDay: types.NewCoinFromInt64(int64(i + 1)), CreatedAt: int64(i)}
+			assert.Nil(t, env.ps.SetPostReportOrUpvote(env.ctx, permlink, &reportOrUpvote))
+			reportOrUpvotes = append(reportOrUpvotes, reportOrUpvote)
+			donation := Donation{Username: user, Amount: types.NewCoinFromInt64(int64(i + 1)), CreatedAt: int64(i)}
+			assert.Nil(t, env.ps.AddPostDonation(env.ctx, permlink, &donation))
+			donations = append(donations, donation)
+		}
+
+		commentPage, err := env.ps.GetPostCommentPage(env.ctx, permlink, "", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, &CommentPage{Comments: comments[:1], Next: "user2#comment"}, commentPage)
+		commentPage, err = env.ps.GetPostCommentPage(env.ctx, permlink, "user2#comment", 5)
+		assert.Nil(t, err)
+		assert.Equal(t, &CommentPage{Comments: comments[1:], Next: ""}, commentPage)
+
+		viewPage, err := env.ps.GetPostViewPage(env.ctx, permlink, "user2", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, &ViewPage{Views: views[1:2], Next: "user3"}, viewPage)
+		viewPage, err = env.ps.GetPostViewPage(env.ctx, "author#other", "", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, &ViewPage{Views: []View{}, Next: ""}, viewPage)
+
+		reportOrUpvotePage, err := env.ps.GetPostReportOrUpvotePage(env.ctx, permlink, "", 3)
+		assert.Nil(t, err)
+		assert.Equal(t, &ReportOrUpvotePage{ReportOrUpvotes: reportOrUpvotes, Next: ""}, reportOrUpvotePage)
+
+		donationPage, err := env.ps.GetPostDonationPage(env.ctx, permlink, 0, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &DonationPage{Donations: donations[:2], Next: "2"}, donationPage)
+		donationPage, err = env.ps.GetPostDonationPage(env.ctx, permlink, 2, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &DonationPage{Donations: donations[2:], Next: ""}, donationPage)
+
+		revisions := []PostRevision{}
+		for i := int64(1); i <= 3; i++ {
+			revision := PostRevision{Revision: i, Hash: "hash", ReplacedAt: i}
+			assert.Nil(t, env.ps.AddPostRevision(env.ctx, permlink, &revision))
+			revisions = append(revisions, revision)
+		}
+		revisionPage, err := env.ps.GetPostRevisionPage(env.ctx, permlink, 0, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &RevisionPage{Revisions: revisions[:2], Next: "3"}, revisionPage)
+		revisionPage, err = env.ps.GetPostRevisionPage(env.ctx, permlink, 3, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &RevisionPage{Revisions: revisions[2:], Next: ""}, revisionPage)
+
+		subscriptions := []Subscription{}
+		for _, postID := range []string{"p1", "p2", "p3"} {
+			subscription := Subscription{
+				Subscriber: "user1", Author: "author", PostID: postID, Amount: types.NewCoinFromInt64(1)}
+			assert.Nil(t, env.ps.SetSubscription(env.ctx, &subscription))
+			subscriptions = append(subscriptions, subscription)
+		}
+		subscriptionPage, err := env.ps.GetSubscriptionPage(env.ctx, "user1", "", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &SubscriptionPage{Subscriptions: subscriptions[:2], Next: "author#p3"}, subscriptionPage)
+		subscriptionPage, err = env.ps.GetSubscriptionPage(env.ctx, "user1", "author#p3", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &SubscriptionPage{Subscriptions: subscriptions[2:], Next: ""}, subscriptionPage)
+	})
+}
+
+func TestPostTags(t *testing.T) {
+	runTest(t, func(env TestEnv) {
+		posts := []PostInfo{}
+		// post id order is different from creation order
+		for i, postID := range []string{"p3", "p1", "p2"} {
+			postInfo := PostInfo{PostID: postID, Author: "author", Tags: []string{"music"}}
+			assert.Nil(t, env.ps.SetPostInfo(env.ctx, &postInfo))
+			env.ps.SetPostTag(env.ctx, "music", int64(i), types.GetPermlink("author", postID))
+			posts = append(posts, postInfo)
+		}
+		// posts with tag sharing the same prefix are not included
+		env.ps.SetPostTag(env.ctx, "musical", 0, types.GetPermlink("author", "p1"))
+
+		tagPage, err := env.ps.GetTagPostPage(env.ctx, "music", nil, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, posts[:2], tagPage.Posts)
+		assert.NotEqual(t, "", tagPage.Next)
+		next, decodeErr := hex.DecodeString(tagPage.Next)
+		assert.Nil(t, decodeErr)
+		tagPage, err = env.ps.GetTagPostPage(env.ctx, "music", next, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, &TagPostPage{Posts: posts[2:], Next: ""}, tagPage)
+
+		env.ps.DeletePostTag(env.ctx, "music", 0, types.GetPermlink("author", "p3"))
+		tagPage, err = env.ps.GetTagPostPage(env.ctx, "music", nil, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, &TagPostPage{Posts: posts[1:], Next: ""}, tagPage)
+	})
+}
+
 //
 // Test Environment setup
 //
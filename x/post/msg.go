@@ -2,6 +2,7 @@ package post
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
@@ -15,6 +16,12 @@ var _ types.Msg = DeletePostMsg{}
 var _ types.Msg = DonateMsg{}
 var _ types.Msg = ReportOrUpvoteMsg{}
 var _ types.Msg = ViewMsg{}
+var _ types.Msg = SubscribeMsg{}
+var _ types.Msg = CancelSubscriptionMsg{}
+
+var _ types.AppMsg = CreatePostMsg{}
+var _ types.AppMsg = DonateMsg{}
+var _ types.AppMsg = SubscribeMsg{}
 
 // CreatePostMsg contains information to create a post
 type CreatePostMsg struct {
@@ -28,15 +35,19 @@ type CreatePostMsg struct {
 	SourcePostID            string                 `json:"source_postID"`
 	Links                   []types.IDToURLMapping `json:"links"`
 	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
+	Tags                    []string               `json:"tags,omitempty"`
+	FromApp                 types.AccountKey       `json:"from_app,omitempty"`
 }
 
 // UpdatePostMsg - update post
 type UpdatePostMsg struct {
-	Author  types.AccountKey       `json:"author"`
-	PostID  string                 `json:"post_id"`
-	Title   string                 `json:"title"`
-	Content string                 `json:"content"`
-	Links   []types.IDToURLMapping `json:"links"`
+	Author              types.AccountKey       `json:"author"`
+	PostID              string                 `json:"post_id"`
+	Title               string                 `json:"title"`
+	Content             string                 `json:"content"`
+	Links               []types.IDToURLMapping `json:"links"`
+	Tags                []string               `json:"tags,omitempty"`
+	KeepRevisionContent bool                   `json:"keep_revision_content,omitempty"`
 }
 
 // DeletePostMsg - sent from a user to a post
@@ -55,6 +66,26 @@ type DonateMsg struct {
 	Memo     string           `json:"memo"`
 }
 
+// SubscribeMsg - subscribe to a post with a recurring donation of @p Amount
+// every @p IntervalSec, for at most @p Periods payments
+type SubscribeMsg struct {
+	Username    types.AccountKey `json:"username"`
+	Amount      types.LNO        `json:"amount"`
+	Author      types.AccountKey `json:"author"`
+	PostID      string           `json:"post_id"`
+	FromApp     types.AccountKey `json:"from_app"`
+	Memo        string           `json:"memo"`
+	IntervalSec int64            `json:"interval_sec"`
+	Periods     int64            `json:"periods"`
+}
+
+// CancelSubscriptionMsg - cancel subscription to a post
+type CancelSubscriptionMsg struct {
+	Username types.AccountKey `json:"username"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+}
+
 // ViewMsg - sent from a user to a post
 type ViewMsg struct {
 	Username types.AccountKey `json:"username"`
@@ -74,7 +105,7 @@ type ReportOrUpvoteMsg struct {
 func NewCreatePostMsg(
 	author, postID, title, content, parentAuthor, parentPostID,
 	sourceAuthor, sourcePostID, redistributionSplitRate string,
-	links []types.IDToURLMapping) CreatePostMsg {
+	links []types.IDToURLMapping, tags []string) CreatePostMsg {
 	return CreatePostMsg{
 		Author:       types.AccountKey(author),
 		PostID:       postID,
@@ -86,18 +117,20 @@ func NewCreatePostMsg(
 		SourcePostID: sourcePostID,
 		Links:        links,
 		RedistributionSplitRate: redistributionSplitRate,
+		Tags:                    tags,
 	}
 }
 
 // NewUpdatePostMsg - constructs a UpdatePost msg
 func NewUpdatePostMsg(
-	author, postID, title, content string, links []types.IDToURLMapping) UpdatePostMsg {
+	author, postID, title, content string, links []types.IDToURLMapping, tags []string) UpdatePostMsg {
 	return UpdatePostMsg{
 		Author:  types.AccountKey(author),
 		PostID:  postID,
 		Title:   title,
 		Content: content,
 		Links:   links,
+		Tags:    tags,
 	}
 }
 
@@ -167,6 +200,43 @@ func (msg DonateMsg) Route() string { return RouterKey }
 // Type - implements sdk.Msg
 func (msg DonateMsg) Type() string { return "DonateMsg" }
 
+// NewSubscribeMsg - constructs a subscribe msg
+func NewSubscribeMsg(
+	user string, amount types.LNO, author string, postID string,
+	fromApp string, memo string, intervalSec int64, periods int64) SubscribeMsg {
+	return SubscribeMsg{
+		Username:    types.AccountKey(user),
+		Amount:      amount,
+		Author:      types.AccountKey(author),
+		PostID:      postID,
+		FromApp:     types.AccountKey(fromApp),
+		Memo:        memo,
+		IntervalSec: intervalSec,
+		Periods:     periods,
+	}
+}
+
+// NewCancelSubscriptionMsg - constructs a cancel subscription msg
+func NewCancelSubscriptionMsg(user string, author string, postID string) CancelSubscriptionMsg {
+	return CancelSubscriptionMsg{
+		Username: types.AccountKey(user),
+		Author:   types.AccountKey(author),
+		PostID:   postID,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SubscribeMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SubscribeMsg) Type() string { return "SubscribeMsg" }
+
+// Route - implements sdk.Msg
+func (msg CancelSubscriptionMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg CancelSubscriptionMsg) Type() string { return "CancelSubscriptionMsg" }
+
 // Route - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) Route() string { return RouterKey }
 
@@ -218,6 +288,10 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 		}
 	}
 
+	if err := validatePostTags(msg.Tags); err != nil {
+		return err
+	}
+
 	splitRate, err := sdk.NewDecFromStr(msg.RedistributionSplitRate)
 	if err != nil {
 		return err
@@ -252,6 +326,30 @@ func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 			return ErrURLLengthTooLong()
 		}
 	}
+
+	if err := validatePostTags(msg.Tags); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validatePostTags - tags are bounded in number and length, unique,
+// and can't contain key separator since tag is part of the tag index key
+func validatePostTags(tags []string) sdk.Error {
+	if len(tags) > types.MaximumNumOfPostTags {
+		return ErrTooManyPostTags()
+	}
+	for i, tag := range tags {
+		if len(tag) == 0 || len(tag) > types.MaximumLengthOfPostTag ||
+			strings.Contains(tag, types.KeySeparator) {
+			return ErrInvalidPostTag(tag)
+		}
+		for _, prev := range tags[:i] {
+			if prev == tag {
+				return ErrDuplicatePostTag(tag)
+			}
+		}
+	}
 	return nil
 }
 
@@ -288,6 +386,40 @@ func (msg DonateMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg SubscribeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(msg.Memo) > types.MaximumMemoLength {
+		return ErrInvalidMemo()
+	}
+	if msg.IntervalSec < types.MinimumSubscriptionIntervalSec {
+		return ErrInvalidSubscriptionInterval(msg.IntervalSec)
+	}
+	if msg.Periods <= 0 || msg.Periods > types.MaximumNumOfSubscriptionPeriods {
+		return ErrInvalidSubscriptionPeriods(msg.Periods)
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg CancelSubscriptionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	return nil
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) ValidateBasic() sdk.Error {
 	if len(msg.Username) == 0 {
@@ -330,6 +462,16 @@ func (msg DonateMsg) GetPermission() types.Permission {
 	return types.PreAuthorizationPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg SubscribeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg CancelSubscriptionMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
 // GetPermission - implements types.Msg
 func (msg ReportOrUpvoteMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -340,6 +482,21 @@ func (msg ViewMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetFromApp - implements types.AppMsg
+func (msg CreatePostMsg) GetFromApp() types.AccountKey {
+	return msg.FromApp
+}
+
+// GetFromApp - implements types.AppMsg
+func (msg DonateMsg) GetFromApp() types.AccountKey {
+	return msg.FromApp
+}
+
+// GetFromApp - implements types.AppMsg
+func (msg SubscribeMsg) GetFromApp() types.AccountKey {
+	return msg.FromApp
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg CreatePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -360,6 +517,16 @@ func (msg DonateMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg SubscribeMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg CancelSubscriptionMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -398,6 +565,16 @@ func (msg DonateMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg SubscribeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg CancelSubscriptionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
 // GetSigners - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
@@ -411,14 +588,14 @@ func (msg ViewMsg) GetSigners() []sdk.AccAddress {
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf("Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, parentAuthor:%v,"+
-		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v}",
+		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v, tags:%v}",
 		msg.Author, msg.PostID, msg.Title, msg.Content, msg.ParentAuthor, msg.ParentPostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.Links, msg.RedistributionSplitRate)
+		msg.Links, msg.RedistributionSplitRate, msg.Tags)
 }
 
 func (msg UpdatePostMsg) String() string {
-	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v}",
-		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links)
+	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v, tags:%v, keepRevisionContent:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags, msg.KeepRevisionContent)
 }
 
 func (msg DeletePostMsg) String() string {
@@ -431,6 +608,18 @@ func (msg DonateMsg) String() string {
 		msg.Username, msg.Amount, msg.Author, msg.PostID)
 }
 
+func (msg SubscribeMsg) String() string {
+	return fmt.Sprintf(
+		"Post.SubscribeMsg{subscriber: %v, amount: %v, post author:%v, post id: %v, interval: %v, periods: %v}",
+		msg.Username, msg.Amount, msg.Author, msg.PostID, msg.IntervalSec, msg.Periods)
+}
+
+func (msg CancelSubscriptionMsg) String() string {
+	return fmt.Sprintf(
+		"Post.CancelSubscriptionMsg{subscriber: %v, post author:%v, post id: %v}",
+		msg.Username, msg.Author, msg.PostID)
+}
+
 func (msg ReportOrUpvoteMsg) String() string {
 	return fmt.Sprintf(
 		"Post.ReportOrUpvoteMsg{from: %v, post author:%v, post id: %v}",
@@ -464,6 +653,16 @@ func (msg DonateMsg) GetConsumeAmount() types.Coin {
 	return coin
 }
 
+// GetConsumeAmount - implements types.Msg
+func (msg SubscribeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg CancelSubscriptionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
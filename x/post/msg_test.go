@@ -56,7 +56,7 @@ func getCommentAndRepost(
 	t *testing.T, parentAuthor, parentPostID, sourceAuthor, sourcePostID string) CreatePostMsg {
 	return NewCreatePostMsg(
 		"author", "TestPostID", string(make([]byte, 100)), string(make([]byte, 1000)),
-		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil)
+		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil, nil)
 }
 
 func TestCreatePostMsg(t *testing.T) {
@@ -285,65 +285,107 @@ func TestUpdatePostMsg(t *testing.T) {
 		{
 			testName: "normal case 1",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "normal case 2",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 title",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 content",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}),
+				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "no author",
 			updatePostMsg: NewUpdatePostMsg(
-				"", "postID", "title", "content", []types.IDToURLMapping{}),
+				"", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoAuthor(),
 		},
 		{
 			testName: "no post id",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "", "title", "content", []types.IDToURLMapping{}),
+				"author", "", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoPostID(),
 		},
 		{
 			testName: "post tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", string(make([]byte, 101)), "content", []types.IDToURLMapping{}),
+				"author", "postID", string(make([]byte, 101)), "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 100)), string(make([]byte, 1001)),
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 100)), tooLongOfUTF8Content,
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
+		{
+			testName: "post with tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{"music", "中文"}),
+			expectedResult: nil,
+		},
+		{
+			testName: "too many tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{"t1", "t2", "t3", "t4", "t5", "t6"}),
+			expectedResult: ErrTooManyPostTags(),
+		},
+		{
+			testName: "empty tag",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{""}),
+			expectedResult: ErrInvalidPostTag(""),
+		},
+		{
+			testName: "tag is too long",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{string(make([]byte, 31))}),
+			expectedResult: ErrInvalidPostTag(string(make([]byte, 31))),
+		},
+		{
+			testName: "tag contains key separator",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{"a/b"}),
+			expectedResult: ErrInvalidPostTag("a/b"),
+		},
+		{
+			testName: "duplicate tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{},
+				[]string{"music", "music"}),
+			expectedResult: ErrDuplicatePostTag("music"),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.updatePostMsg.ValidateBasic()
@@ -523,6 +565,79 @@ func TestDonationMsg(t *testing.T) {
 	}
 }
 
+func TestSubscriptionMsg(t *testing.T) {
+	interval := int64(types.MinimumSubscriptionIntervalSec)
+	testCases := []struct {
+		testName      string
+		msg           types.Msg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewSubscribeMsg("test", types.LNO("1"), "author", "postID", "", memo1, interval, 12),
+			expectedError: nil,
+		},
+		{
+			testName:      "no username",
+			msg:           NewSubscribeMsg("", types.LNO("1"), "author", "postID", "", memo1, interval, 12),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target",
+			msg:           NewSubscribeMsg("test", types.LNO("1"), "author", "", "", memo1, interval, 12),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "zero coin is less than lower bound",
+			msg:           NewSubscribeMsg("test", types.LNO("0"), "author", "postID", "", memo1, interval, 12),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:      "utf8 memo is too long",
+			msg:           NewSubscribeMsg("test", types.LNO("1"), "author", "postID", "", tooLongOfUTF8Memo, interval, 12),
+			expectedError: ErrInvalidMemo(),
+		},
+		{
+			testName:      "interval is too short",
+			msg:           NewSubscribeMsg("test", types.LNO("1"), "author", "postID", "", memo1, interval-1, 12),
+			expectedError: ErrInvalidSubscriptionInterval(interval - 1),
+		},
+		{
+			testName:      "zero periods",
+			msg:           NewSubscribeMsg("test", types.LNO("1"), "author", "postID", "", memo1, interval, 0),
+			expectedError: ErrInvalidSubscriptionPeriods(0),
+		},
+		{
+			testName: "too many periods",
+			msg: NewSubscribeMsg(
+				"test", types.LNO("1"), "author", "postID", "", memo1, interval, types.MaximumNumOfSubscriptionPeriods+1),
+			expectedError: ErrInvalidSubscriptionPeriods(types.MaximumNumOfSubscriptionPeriods + 1),
+		},
+		{
+			testName:      "cancel subscription",
+			msg:           NewCancelSubscriptionMsg("test", "author", "postID"),
+			expectedError: nil,
+		},
+		{
+			testName:      "cancel subscription without username",
+			msg:           NewCancelSubscriptionMsg("", "author", "postID"),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "cancel subscription with invalid target",
+			msg:           NewCancelSubscriptionMsg("test", "", "postID"),
+			expectedError: ErrInvalidTarget(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestReportOrUpvoteMsg(t *testing.T) {
 	testCases := []struct {
 		testName          string
@@ -660,7 +775,18 @@ func TestMsgPermission(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
+			expectedPermission: types.AppPermission,
+		},
+		{
+			testName: "subscribe",
+			msg: NewSubscribeMsg(
+				"test", types.LNO("1"), "author", "postID", "", memo1, types.MinimumSubscriptionIntervalSec, 12),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "cancel subscription",
+			msg:                NewCancelSubscriptionMsg("test", "author", "postID"),
 			expectedPermission: types.AppPermission,
 		},
 	}
@@ -722,7 +848,7 @@ func TestGetSignBytes(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 		},
 	}
 
@@ -786,7 +912,7 @@ func TestGetSigners(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectSigners: []types.AccountKey{"author"},
 		},
 	}
@@ -860,7 +986,7 @@ func TestGetConsumeAmount(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectAmount: types.NewCoinFromInt64(0),
 		},
 	}
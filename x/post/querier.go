@@ -1,6 +1,9 @@
 package post
 
 import (
+	"encoding/hex"
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
@@ -17,11 +20,22 @@ const (
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
-	QueryPostInfo           = "info"
-	QueryPostMeta           = "meta"
-	QueryPostReportOrUpvote = "reportOrUpvote"
-	QueryPostComment        = "comment"
-	QueryPostView           = "view"
+	QueryPostInfo               = "info"
+	QueryPostMeta               = "meta"
+	QueryPostReportOrUpvote     = "reportOrUpvote"
+	QueryPostComment            = "comment"
+	QueryPostView               = "view"
+	QueryPostsByAuthor          = "postsByAuthor"
+	QueryPostCommentPage        = "comments"
+	QueryPostViewPage           = "views"
+	QueryPostReportOrUpvotePage = "reportOrUpvotes"
+	QueryPostDonationPage       = "donationPage"
+	QueryPostsByTag             = "tag"
+	QueryPostRevisions          = "revisions"
+	QuerySubscriptions          = "subscriptions"
+
+	// MaxPageSize - the most records returned by one paginated query
+	MaxPageSize int64 = 100
 )
 
 // creates a querier for post REST endpoints
@@ -36,6 +50,22 @@ func NewQuerier(pm PostManager) sdk.Querier {
 			return queryPostMeta(ctx, cdc, path[1:], req, pm)
 		case QueryPostReportOrUpvote:
 			return queryReportOrUpvote(ctx, cdc, path[1:], req, pm)
+		case QueryPostsByAuthor:
+			return queryPostsByAuthor(ctx, cdc, path[1:], req, pm)
+		case QueryPostCommentPage:
+			return queryPostCommentPage(ctx, cdc, path[1:], req, pm)
+		case QueryPostViewPage:
+			return queryPostViewPage(ctx, cdc, path[1:], req, pm)
+		case QueryPostReportOrUpvotePage:
+			return queryPostReportOrUpvotePage(ctx, cdc, path[1:], req, pm)
+		case QueryPostDonationPage:
+			return queryPostDonationPage(ctx, cdc, path[1:], req, pm)
+		case QueryPostsByTag:
+			return queryPostsByTag(ctx, cdc, path[1:], req, pm)
+		case QueryPostRevisions:
+			return queryPostRevisions(ctx, cdc, path[1:], req, pm)
+		case QuerySubscriptions:
+			return querySubscriptions(ctx, cdc, path[1:], req, pm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown post query endpoint")
 		}
@@ -86,3 +116,218 @@ func queryReportOrUpvote(ctx sdk.Context, cdc *wire.Codec, path []string, req ab
 	}
 	return res, nil
 }
+
+// queryPostRevisions - path: permlink[/start[/limit]], revisions are returned from the oldest
+// to the latest, pass next of the result as start to get the following page.
+func queryPostRevisions(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := parseSeqCursor(start)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetPostRevisionPage(ctx, types.Permlink(path[0]), revision, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// querySubscriptions - path: subscriber[/start[/limit]], subscriptions are returned in
+// permlink order, pass next of the result as start to get the following page.
+func querySubscriptions(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetSubscriptionPage(
+		ctx, types.AccountKey(path[0]), types.Permlink(start), limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// parsePageCursor - parse optional start and limit after the first element of @p path,
+// limit is capped by MaxPageSize.
+func parsePageCursor(path []string) (string, int64, sdk.Error) {
+	start, limit := "", MaxPageSize
+	if len(path) > 1 {
+		start = path[1]
+	}
+	if len(path) > 2 {
+		v, err := strconv.ParseInt(path[2], 10, 64)
+		if err != nil || v <= 0 {
+			return "", 0, types.ErrInvalidQueryPath()
+		}
+		if v < limit {
+			limit = v
+		}
+	}
+	return start, limit, nil
+}
+
+// parseSeqCursor - parse cursor @p start of records keyed by sequence, empty for the first.
+func parseSeqCursor(start string) (int64, sdk.Error) {
+	if start == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(start, 10, 64)
+	if err != nil || v < 0 {
+		return 0, types.ErrInvalidQueryPath()
+	}
+	return v, nil
+}
+
+// queryPostsByAuthor - path: author[/start[/limit]], posts are returned in post ID order,
+// pass next of the result as start to get the following page.
+func queryPostsByAuthor(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetPostInfoPage(ctx, types.AccountKey(path[0]), start, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryPostCommentPage - path: permlink[/start[/limit]], comments are returned in
+// comment permlink order, pass next of the result as start to get the following page.
+func queryPostCommentPage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetPostCommentPage(
+		ctx, types.Permlink(path[0]), types.Permlink(start), limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryPostViewPage - path: permlink[/start[/limit]], views are returned in username order,
+// pass next of the result as start to get the following page.
+func queryPostViewPage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetPostViewPage(
+		ctx, types.Permlink(path[0]), types.AccountKey(start), limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryPostReportOrUpvotePage - path: permlink[/start[/limit]], reports and upvotes are
+// returned in username order, pass next of the result as start to get the following page.
+func queryPostReportOrUpvotePage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetPostReportOrUpvotePage(
+		ctx, types.Permlink(path[0]), types.AccountKey(start), limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryPostDonationPage - path: permlink[/start[/limit]], donations are returned in time order,
+// pass next of the result as start to get the following page.
+func queryPostDonationPage(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := parseSeqCursor(start)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pm.postStorage.GetPostDonationPage(ctx, types.Permlink(path[0]), seq, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryPostsByTag - path: tag[/start[/limit]], posts are returned in creation order,
+// pass next of the result as start to get the following page.
+func queryPostsByTag(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	start, limit, err := parsePageCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	startBytes, decodeErr := hex.DecodeString(start)
+	if decodeErr != nil {
+		return nil, types.ErrInvalidQueryPath()
+	}
+	page, err := pm.postStorage.GetTagPostPage(ctx, path[0], startBytes, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(page)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -60,6 +60,7 @@ func setupTest(
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(SubscriptionEvent{}, "event/subscription", nil)
 
 	err := InitGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -119,7 +120,7 @@ func createTestPost(
 	err = pm.CreatePost(
 		ctx, types.AccountKey(user), postID, "", "", "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		splitRate, []types.IDToURLMapping{}, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -133,7 +134,7 @@ func createTestRepost(
 	err := pm.CreatePost(
 		ctx, types.AccountKey(user), postID, sourceUser, sourcePostID, "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		sdk.ZeroDec(), []types.IDToURLMapping{})
+		sdk.ZeroDec(), []types.IDToURLMapping{}, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -0,0 +1,431 @@
+package vote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// paramCdc - codec without registered concrete types, parameters are encoded
+// the same way as the param querier and the genesis file, without type wrapper.
+var paramCdc = wire.New()
+
+// paramProposalBuilder - decode a parameter file and wrap it in a change param msg
+type paramProposalBuilder func(
+	cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error)
+
+// paramProposalType - param querier route and msg builder of a parameter type
+type paramProposalType struct {
+	route string
+	build paramProposalBuilder
+}
+
+// paramProposalTypes - all parameter types that can be changed by proposal,
+// keyed by the param querier route.
+var paramProposalTypes = map[string]paramProposalType{
+	param.QueryAllocationParam: {
+		route: param.QueryAllocationParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.GlobalAllocationParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeGlobalAllocationParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryInfraInternalAllocationParam: {
+		route: param.QueryInfraInternalAllocationParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.InfraInternalAllocationParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeInfraInternalAllocationParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryVoteParam: {
+		route: param.QueryVoteParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.VoteParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeVoteParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryProposalParam: {
+		route: param.QueryProposalParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.ProposalParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeProposalParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryDeveloperParam: {
+		route: param.QueryDeveloperParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.DeveloperParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeDeveloperParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryValidatorParam: {
+		route: param.QueryValidatorParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.ValidatorParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeValidatorParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryBandwidthParam: {
+		route: param.QueryBandwidthParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.BandwidthParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeBandwidthParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryAccountParam: {
+		route: param.QueryAccountParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.AccountParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeAccountParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryPostParam: {
+		route: param.QueryPostParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.PostParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangePostParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryInfraParam: {
+		route: param.QueryInfraParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.InfraParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeInfraParamMsg(creator, p, reason), nil
+		},
+	},
+	param.QueryFeatureParam: {
+		route: param.QueryFeatureParam,
+		build: func(cdc *wire.Codec, bz []byte, creator, reason string) (param.Parameter, sdk.Msg, error) {
+			p := param.FeatureParam{}
+			if err := cdc.UnmarshalJSON(bz, &p); err != nil {
+				return nil, nil, err
+			}
+			return p, proposal.NewChangeFeatureParamMsg(creator, p, reason), nil
+		},
+	},
+}
+
+func paramProposalTypeNames() []string {
+	names := []string{}
+	for name := range paramProposalTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ChangeParamProposalTxCmd will create a change param proposal tx from a parameter file
+// and sign it with the given key
+func ChangeParamProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-param-proposal",
+		Short: "propose to change a parameter to the content of a JSON file",
+		RunE:  sendChangeParamProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagParamType, "",
+		fmt.Sprintf("parameter to change, one of: %s", strings.Join(paramProposalTypeNames(), ", ")))
+	cmd.Flags().String(client.FlagParamFile, "", "JSON file of the complete new parameter")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	cmd.Flags().Bool(client.FlagDryRun, false, "only validate the parameter file and show the diff")
+	return cmd
+}
+
+func sendChangeParamProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagCreator)
+		paramType := viper.GetString(client.FlagParamType)
+		reason := viper.GetString(client.FlagReason)
+
+		pt, ok := paramProposalTypes[paramType]
+		if !ok {
+			return fmt.Errorf("unknown param type %q, must be one of: %s",
+				paramType, strings.Join(paramProposalTypeNames(), ", "))
+		}
+
+		bz, err := ioutil.ReadFile(viper.GetString(client.FlagParamFile))
+		if err != nil {
+			return err
+		}
+		newParam, msg, err := pt.build(paramCdc, bz, creator, reason)
+		if err != nil {
+			return errors.Wrap(err, "invalid param file")
+		}
+
+		// the file must describe every field of the current param struct and nothing else,
+		// otherwise missing fields would silently be proposed as zero.
+		canonical, err := paramCdc.MarshalJSON(newParam)
+		if err != nil {
+			return err
+		}
+		if err := checkParamFields(bz, canonical); err != nil {
+			return err
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// show the diff against the on-chain value
+		res, err := ctx.QueryRoute(fmt.Sprintf("%s/%s", param.QuerierRoute, pt.route))
+		if err != nil {
+			return err
+		}
+		if err := printParamDiff(res, canonical); err != nil {
+			return err
+		}
+
+		if viper.GetBool(client.FlagDryRun) {
+			return nil
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		txRes, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", txRes.Height, txRes.Hash.String())
+		return nil
+	}
+}
+
+// DeletePostContentProposalTxCmd will create a delete post content proposal tx
+// and sign it with the given key
+func DeletePostContentProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-post-content-proposal",
+		Short: "propose to delete the content of a post",
+		RunE:  sendDeletePostContentProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().Int64(client.FlagRevision, 0, "revision of the post content to delete")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func sendDeletePostContentProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagCreator)
+		author := viper.GetString(client.FlagAuthor)
+		postID := viper.GetString(client.FlagPostID)
+		reason := viper.GetString(client.FlagReason)
+
+		// create the message
+		msg := proposal.NewDeletePostContentMsg(
+			creator, types.GetPermlink(types.AccountKey(author), postID), reason)
+		msg.Revision = viper.GetInt64(client.FlagRevision)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// UpgradeProtocolProposalTxCmd will create a protocol upgrade proposal tx
+// and sign it with the given key
+func UpgradeProtocolProposalTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-protocol-proposal",
+		Short: "propose to upgrade the protocol at a block height",
+		RunE:  sendUpgradeProtocolProposalTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagLink, "", "link to the new protocol")
+	cmd.Flags().String(client.FlagName, "", "name of the upgrade")
+	cmd.Flags().Int64(client.FlagUpgradeHeight, 0, "block height the upgrade takes effect")
+	cmd.Flags().String(client.FlagReason, "", "reason of the proposal")
+	return cmd
+}
+
+func sendUpgradeProtocolProposalTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagCreator)
+		link := viper.GetString(client.FlagLink)
+		name := viper.GetString(client.FlagName)
+		height := viper.GetInt64(client.FlagUpgradeHeight)
+		reason := viper.GetString(client.FlagReason)
+
+		// create the message
+		msg := proposal.NewUpgradeProtocolMsg(creator, link, name, height, reason)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// checkParamFields - compare the fields of the parameter file with the
+// canonical encoding of the param struct it was decoded into.
+func checkParamFields(file, canonical []byte) error {
+	fileFields, err := flattenJSON(file)
+	if err != nil {
+		return err
+	}
+	paramFields, err := flattenJSON(canonical)
+	if err != nil {
+		return err
+	}
+	unknown := []string{}
+	for key := range fileFields {
+		if _, ok := paramFields[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	missing := []string{}
+	for key := range paramFields {
+		if _, ok := fileFields[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(unknown)
+	sort.Strings(missing)
+	if len(unknown) != 0 || len(missing) != 0 {
+		return fmt.Errorf("param file doesn't match the param struct, unknown fields: [%s], missing fields: [%s]",
+			strings.Join(unknown, ", "), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// printParamDiff - print every field that differs between two encoded parameters
+func printParamDiff(old, new []byte) error {
+	oldFields, err := flattenJSON(old)
+	if err != nil {
+		return err
+	}
+	newFields, err := flattenJSON(new)
+	if err != nil {
+		return err
+	}
+	keys := []string{}
+	for key := range oldFields {
+		keys = append(keys, key)
+	}
+	for key := range newFields {
+		if _, ok := oldFields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changed := 0
+	for _, key := range keys {
+		oldValue, oldOk := oldFields[key]
+		newValue, newOk := newFields[key]
+		if oldOk && newOk && oldValue == newValue {
+			continue
+		}
+		if !oldOk {
+			oldValue = "<none>"
+		}
+		if !newOk {
+			newValue = "<none>"
+		}
+		fmt.Printf("%s: %s => %s\n", key, oldValue, newValue)
+		changed++
+	}
+	if changed == 0 {
+		fmt.Println("proposed param is the same as the on-chain param")
+	}
+	return nil
+}
+
+// flattenJSON - map every leaf of a JSON document to its encoded value,
+// keyed by the dot separated path to the leaf.
+func flattenJSON(bz []byte) (map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string)
+	flattenValue("", v, fields)
+	return fields, nil
+}
+
+func flattenValue(prefix string, v interface{}, fields map[string]string) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			flattenValue(join(key), child, fields)
+		}
+	case []interface{}:
+		if len(value) == 0 {
+			fields[prefix] = "[]"
+		}
+		for i, child := range value {
+			flattenValue(join(fmt.Sprintf("%d", i)), child, fields)
+		}
+	case string:
+		fields[prefix] = value
+	default:
+		fields[prefix] = fmt.Sprintf("%v", value)
+	}
+}
@@ -8,9 +8,13 @@ import (
 	"github.com/spf13/cobra"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal"
 	"github.com/lino-network/lino/x/proposal/model"
+	voteModel "github.com/lino-network/lino/x/vote/model"
 )
 
 // GetProposalCmd returns a specific ongoing proposal
@@ -95,3 +99,134 @@ func (c commander) getExpiredProposalCmd(cmd *cobra.Command, args []string) erro
 	fmt.Println(string(output))
 	return nil
 }
+
+// ShowProposalCmd shows a proposal together with its vote tally
+func ShowProposalCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <proposal-id>",
+		Short: "Show a proposal and its vote tally",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("You must provide proposal ID")
+			}
+			return showProposal(cdc, types.ProposalKey(args[0]))
+		},
+	}
+}
+
+// proposalTally - votes of a proposal against its pass requirement
+type proposalTally struct {
+	ProposalID    types.ProposalKey `json:"proposal_id"`
+	Type          string            `json:"type"`
+	Status        string            `json:"status"`
+	AgreeVotes    types.Coin        `json:"agree_votes"`
+	DisagreeVotes types.Coin        `json:"disagree_votes"`
+	TotalVotes    types.Coin        `json:"total_votes"`
+	AgreeRatio    string            `json:"agree_ratio"`
+	PassRatio     string            `json:"pass_ratio"`
+	PassVotes     types.Coin        `json:"pass_votes"`
+	Result        string            `json:"result,omitempty"`
+}
+
+func showProposal(cdc *wire.Codec, proposalID types.ProposalKey) error {
+	ctx := client.NewCoreContextFromViper()
+
+	status := "ongoing"
+	res, err := ctx.QueryRoute(fmt.Sprintf("%s/%s/%s", proposal.QuerierRoute, proposal.QueryOngoingProposal, proposalID))
+	if err != nil {
+		status = "expired"
+		res, err = ctx.QueryRoute(fmt.Sprintf("%s/%s/%s", proposal.QuerierRoute, proposal.QueryExpiredProposal, proposalID))
+		if err != nil {
+			return err
+		}
+	}
+	var p model.Proposal
+	if err := cdc.UnmarshalJSON(res, &p); err != nil {
+		return err
+	}
+
+	res, err = ctx.QueryRoute(fmt.Sprintf("%s/%s", param.QuerierRoute, param.QueryProposalParam))
+	if err != nil {
+		return err
+	}
+	proposalParam := param.ProposalParam{}
+	if err := paramCdc.UnmarshalJSON(res, &proposalParam); err != nil {
+		return err
+	}
+
+	info := p.GetProposalInfo()
+	totalVotes := info.AgreeVotes.Plus(info.DisagreeVotes)
+	tally := proposalTally{
+		ProposalID:    proposalID,
+		Status:        status,
+		AgreeVotes:    info.AgreeVotes,
+		DisagreeVotes: info.DisagreeVotes,
+		TotalVotes:    totalVotes,
+		AgreeRatio:    sdk.ZeroDec().String(),
+	}
+	if totalVotes.IsPositive() {
+		tally.AgreeRatio = info.AgreeVotes.ToDec().Quo(totalVotes.ToDec()).String()
+	}
+	switch p.(type) {
+	case *model.ChangeParamProposal:
+		tally.Type = "change param"
+		tally.PassRatio = proposalParam.ChangeParamPassRatio.String()
+		tally.PassVotes = proposalParam.ChangeParamPassVotes
+	case *model.ContentCensorshipProposal:
+		tally.Type = "content censorship"
+		tally.PassRatio = proposalParam.ContentCensorshipPassRatio.String()
+		tally.PassVotes = proposalParam.ContentCensorshipPassVotes
+	case *model.ProtocolUpgradeProposal:
+		tally.Type = "protocol upgrade"
+		tally.PassRatio = proposalParam.ProtocolUpgradePassRatio.String()
+		tally.PassVotes = proposalParam.ProtocolUpgradePassVotes
+	}
+	if status == "expired" {
+		switch info.Result {
+		case types.ProposalPass:
+			tally.Result = "pass"
+		case types.ProposalRevoked:
+			tally.Result = "revoked"
+		default:
+			tally.Result = "not pass"
+		}
+	}
+
+	if status == "expired" {
+		return client.PrintIndent(p, tally)
+	}
+
+	// projected outcome if the ongoing proposal was decided now
+	res, err = ctx.QueryRoute(fmt.Sprintf("%s/%s/%s", proposal.QuerierRoute, proposal.QueryProposalOutcome, proposalID))
+	if err != nil {
+		return err
+	}
+	outcome := new(model.ProposalOutcome)
+	if err := cdc.UnmarshalJSON(res, outcome); err != nil {
+		return err
+	}
+	return client.PrintIndent(p, tally, outcome)
+}
+
+// GetProposalVotesCmd returns all votes of a proposal
+func GetProposalVotesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes <proposal-id>",
+		Short: "List all votes of a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 {
+				return errors.New("You must provide proposal ID")
+			}
+			res, err := ctx.QueryRoute(fmt.Sprintf("%s/%s/%s", proposal.QuerierRoute, proposal.QueryProposalVotes, args[0]))
+			if err != nil {
+				return err
+			}
+			votes := []voteModel.Vote{}
+			if err := cdc.UnmarshalJSON(res, &votes); err != nil {
+				return err
+			}
+			return client.PrintIndent(votes)
+		},
+	}
+}
@@ -76,3 +76,23 @@ func ErrIllegalParameter() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeProposalQueryFailed, fmt.Sprintf("query proposal store failed"))
 }
+
+// ErrInvalidCensorshipRevision - error if content censorship revision is invalid or not in post revision log
+func ErrInvalidCensorshipRevision(revision int64) sdk.Error {
+	return types.NewError(types.CodeInvalidCensorshipRevision, fmt.Sprintf("invalid censorship revision %v", revision))
+}
+
+// ErrInvalidUpgradeName - error if protocol upgrade name is empty or too long
+func ErrInvalidUpgradeName() sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradeName, fmt.Sprintf("invalid upgrade name"))
+}
+
+// ErrInvalidUpgradeHeight - error if protocol upgrade height is not in the future
+func ErrInvalidUpgradeHeight(height int64) sdk.Error {
+	return types.NewError(types.CodeInvalidUpgradeHeight, fmt.Sprintf("invalid upgrade height %v", height))
+}
+
+// ErrUpgradePlanAlreadyExist - error if another upgrade plan is pending
+func ErrUpgradePlanAlreadyExist() sdk.Error {
+	return types.NewError(types.CodeUpgradePlanAlreadyExist, fmt.Sprintf("upgrade plan already exist"))
+}
@@ -1,6 +1,8 @@
 package proposal
 
 import (
+	"fmt"
+
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post"
 	"github.com/lino-network/lino/x/vote"
@@ -41,7 +43,7 @@ func (dpe DecideProposalEvent) Execute(
 	}
 
 	// punish validators who didn't vote
-	actualPenalty, err := valManager.PunishValidatorsDidntVote(ctx, penaltyList.PenaltyList)
+	actualPenalty, err := valManager.PunishValidatorsDidntVote(ctx, penaltyList.PenaltyList, gm)
 	if err != nil {
 		return err
 	}
@@ -57,6 +59,9 @@ func (dpe DecideProposalEvent) Execute(
 	if err != nil {
 		return err
 	}
+	if err := voteManager.DeleteVoteOverrides(ctx, dpe.ProposalID); err != nil {
+		return err
+	}
 	// majority disagree this proposal
 	if proposalRes == types.ProposalNotPass {
 		return nil
@@ -113,8 +118,31 @@ func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	return nil
 }
 
-// ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, the process need to be done manually
+// ExecuteProtocolUpgrade - schedule the upgrade plan, the chain halts at the plan height
+// until a binary implementing the upgrade takes over.
 func (dpe DecideProposalEvent) ExecuteProtocolUpgrade(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
-	return nil
+	plan, err := proposalManager.CreateUpgradePlan(ctx, curID)
+	if err != nil {
+		return err
+	}
+	// the proposal was decided too late to halt the chain at the planned height
+	if plan.Height <= ctx.BlockHeader().Height {
+		ctx.Logger().Error(fmt.Sprintf(
+			"upgrade %s of proposal %s is dropped, height %d has passed", plan.Name, curID, plan.Height))
+		return nil
+	}
+	// the running binary already implements the upgrade, nothing to halt for
+	if plan.Name == types.ProtocolUpgradeName {
+		ctx.Logger().Error(fmt.Sprintf(
+			"upgrade %s of proposal %s is dropped, it's the running protocol", plan.Name, curID))
+		return nil
+	}
+	// a pending plan is never replaced
+	if proposalManager.DoesUpgradePlanExist(ctx) {
+		ctx.Logger().Error(fmt.Sprintf(
+			"upgrade %s of proposal %s is dropped, another upgrade is pending", plan.Name, curID))
+		return nil
+	}
+	return proposalManager.SetUpgradePlan(ctx, plan)
 }
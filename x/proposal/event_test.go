@@ -217,3 +217,54 @@ func TestDecideProposal(t *testing.T) {
 		assert.Equal(t, expectExpiredProposalList, expiredList)
 	}
 }
+
+func TestExecuteProtocolUpgrade(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 10)
+	pm.InitGenesis(ctx)
+
+	p1 := pm.CreateProtocolUpgradeProposal(ctx, "link", "upgrade2", 100, "")
+	p2 := pm.CreateProtocolUpgradeProposal(ctx, "link", "upgrade3", 5, "")
+	p3 := pm.CreateProtocolUpgradeProposal(ctx, "link", "upgrade4", 200, "")
+	p4 := pm.CreateProtocolUpgradeProposal(ctx, "link", types.ProtocolUpgradeName, 200, "")
+	id1, err := pm.AddProposal(ctx, types.AccountKey("c1"), p1, 10)
+	assert.Nil(t, err)
+	id2, err := pm.AddProposal(ctx, types.AccountKey("c2"), p2, 10)
+	assert.Nil(t, err)
+	id3, err := pm.AddProposal(ctx, types.AccountKey("c3"), p3, 10)
+	assert.Nil(t, err)
+	id4, err := pm.AddProposal(ctx, types.AccountKey("c4"), p4, 10)
+	assert.Nil(t, err)
+	for _, id := range []types.ProposalKey{id1, id2, id3, id4} {
+		p, err := pm.storage.GetOngoingProposal(ctx, id)
+		assert.Nil(t, err)
+		assert.Nil(t, pm.storage.SetExpiredProposal(ctx, id, p))
+	}
+
+	e1 := DecideProposalEvent{ProposalType: types.ProtocolUpgrade, ProposalID: id1}
+	assert.Nil(t, e1.ExecuteProtocolUpgrade(ctx, id1, pm))
+	plan, err := pm.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, model.UpgradePlan{ProposalID: id1, Name: "upgrade2", Height: 100, Link: "link"}, *plan)
+
+	// plan whose height has passed is dropped
+	e2 := DecideProposalEvent{ProposalType: types.ProtocolUpgrade, ProposalID: id2}
+	assert.Nil(t, e2.ExecuteProtocolUpgrade(ctx, id2, pm))
+	plan, err = pm.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "upgrade2", plan.Name)
+
+	// pending plan is not replaced
+	e3 := DecideProposalEvent{ProposalType: types.ProtocolUpgrade, ProposalID: id3}
+	assert.Nil(t, e3.ExecuteProtocolUpgrade(ctx, id3, pm))
+	plan, err = pm.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "upgrade2", plan.Name)
+
+	pm.DeleteUpgradePlan(ctx)
+	assert.False(t, pm.DoesUpgradePlanExist(ctx))
+
+	// upgrade implemented by the running binary is dropped
+	e4 := DecideProposalEvent{ProposalType: types.ProtocolUpgrade, ProposalID: id4}
+	assert.Nil(t, e4.ExecuteProtocolUpgrade(ctx, id4, pm))
+	assert.False(t, pm.DoesUpgradePlanExist(ctx))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post"
@@ -41,6 +42,13 @@ func handleChangeParamMsg(
 		return ErrAccountNotFound().Result()
 	}
 
+	// reject feature changes which can't be applied when the proposal passes
+	if featureParam, ok := msg.GetParameter().(param.FeatureParam); ok {
+		if err := pm.paramHolder.CheckFeatureParamChange(ctx, featureParam); err != nil {
+			return err.Result()
+		}
+	}
+
 	param, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return err.Result()
@@ -70,7 +78,8 @@ func handleChangeParamMsg(
 		param.ChangeParamDecideSec, param.ChangeParamMinDeposit); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewProposalTags(msg.GetCreator(), proposalID).AppendTags(
+		types.NewTransferTags("", "", param.ChangeParamMinDeposit, types.ProposalDeposit))}
 }
 
 func handleProtocolUpgradeMsg(
@@ -79,13 +88,24 @@ func handleProtocolUpgradeMsg(
 	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
 		return ErrAccountNotFound().Result()
 	}
+	if msg.GetHeight() <= ctx.BlockHeader().Height {
+		return ErrInvalidUpgradeHeight(msg.GetHeight()).Result()
+	}
+	// the running binary already implements this upgrade
+	if msg.GetName() == types.ProtocolUpgradeName {
+		return ErrInvalidUpgradeName().Result()
+	}
+	if pm.DoesUpgradePlanExist(ctx) {
+		return ErrUpgradePlanAlreadyExist().Result()
+	}
 
 	param, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return err.Result()
 	}
 
-	proposal := pm.CreateProtocolUpgradeProposal(ctx, msg.GetLink(), msg.GetReason())
+	proposal := pm.CreateProtocolUpgradeProposal(
+		ctx, msg.GetLink(), msg.GetName(), msg.GetHeight(), msg.GetReason())
 	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ProtocolUpgradeDecideSec)
 	if err != nil {
 		return err.Result()
@@ -109,7 +129,8 @@ func handleProtocolUpgradeMsg(
 		param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewProposalTags(msg.GetCreator(), proposalID).AppendTags(
+		types.NewTransferTags("", "", param.ProtocolUpgradeMinDeposit, types.ProposalDeposit))}
 }
 
 func handleContentCensorshipMsg(
@@ -127,6 +148,15 @@ func handleContentCensorshipMsg(
 		return ErrCensorshipPostIsDeleted(msg.GetPermlink()).Result()
 	}
 
+	revision := msg.GetRevision()
+	if revision == 0 {
+		revision = postManager.GetCurrentPostRevision(ctx, msg.GetPermlink())
+	}
+	revisionHash, err := postManager.GetPostRevisionHash(ctx, msg.GetPermlink(), revision)
+	if err != nil {
+		return ErrInvalidCensorshipRevision(revision).Result()
+	}
+
 	param, err := proposalManager.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return err.Result()
@@ -134,7 +164,7 @@ func handleContentCensorshipMsg(
 
 	proposal :=
 		proposalManager.CreateContentCensorshipProposal(
-			ctx, msg.GetPermlink(), msg.GetReason())
+			ctx, msg.GetPermlink(), revision, revisionHash, msg.GetReason())
 	proposalID, err :=
 		proposalManager.AddProposal(
 			ctx, msg.GetCreator(), proposal, param.ContentCensorshipDecideSec)
@@ -159,7 +189,9 @@ func handleContentCensorshipMsg(
 		param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewProposalTags(msg.GetCreator(), proposalID).
+		AppendTag(types.TagPermlink, string(msg.GetPermlink())).AppendTags(
+		types.NewTransferTags("", "", param.ContentCensorshipMinDeposit, types.ProposalDeposit))}
 }
 
 func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager, msg VoteProposalMsg) sdk.Result {
@@ -171,10 +203,23 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return ErrNotOngoingProposal().Result()
 	}
 
-	if err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result); err != nil {
+	overridden, err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result)
+	if err != nil {
 		return err.Result()
 	}
 
+	// delegated power moves out of votes already cast by the voters delegated to
+	for _, override := range overridden {
+		voterVote, err := vm.GetVote(ctx, msg.ProposalID, override.Voter)
+		if err != nil {
+			return err.Result()
+		}
+		if err := proposalManager.DeductProposalVotes(
+			ctx, msg.ProposalID, voterVote.Result, override.Amount); err != nil {
+			return err.Result()
+		}
+	}
+
 	v, err := vm.GetVote(ctx, msg.ProposalID, msg.Voter)
 	if err != nil {
 		return err.Result()
@@ -185,7 +230,7 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewProposalTags(msg.Voter, msg.ProposalID)}
 }
 
 func returnCoinTo(
@@ -52,6 +52,8 @@ func TestChangeParamProposal(t *testing.T) {
 		},
 		Param:  allocation,
 		Reason: ""}
+	wantRes := sdk.Result{Tags: types.NewProposalTags(user1, proposalID1).AppendTags(
+		types.NewTransferTags("", "", proposalParam.ChangeParamMinDeposit, types.ProposalDeposit))}
 
 	testCases := []struct {
 		testName            string
@@ -71,7 +73,7 @@ func TestChangeParamProposal(t *testing.T) {
 			},
 			proposalID:          proposalID1,
 			wantOK:              true,
-			wantRes:             sdk.Result{},
+			wantRes:             wantRes,
 			wantCreatorBalance:  c460000.Minus(proposalParam.ChangeParamMinDeposit),
 			wantOngoingProposal: []model.Proposal{proposal1},
 			wantProposal:        proposal1,
@@ -124,6 +126,72 @@ func TestChangeParamProposal(t *testing.T) {
 	}
 }
 
+func TestChangeFeatureParamProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, types.BlockchainUpgrade1Update1Height)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+	wantRes := sdk.Result{Tags: types.NewProposalTags(user1, types.ProposalKey("1")).AppendTags(
+		types.NewTransferTags("", "", proposalParam.ChangeParamMinDeposit, types.ProposalDeposit))}
+
+	curParam := proposalManager.paramHolder.GetEffectiveFeatureParam(ctx)
+	activatedChanged := param.FeatureParam{Features: append([]param.FeatureActivation{}, curParam.Features...)}
+	activatedChanged.Features[0].Height++
+	passedHeight := param.FeatureParam{Features: append([]param.FeatureActivation{}, curParam.Features...)}
+	passedHeight.Features[1].Height = types.BlockchainUpgrade1Update1Height - 1
+
+	testCases := []struct {
+		testName           string
+		parameter          param.FeatureParam
+		wantRes            sdk.Result
+		wantCreatorBalance types.Coin
+		wantOngoingCount   int
+	}{
+		{
+			testName:           "change activated feature is rejected",
+			parameter:          activatedChanged,
+			wantRes:            param.ErrFeatureAlreadyActivated(curParam.Features[0].Name).Result(),
+			wantCreatorBalance: c460000,
+			wantOngoingCount:   0,
+		},
+		{
+			testName:           "activate feature at passed height is rejected",
+			parameter:          passedHeight,
+			wantRes:            param.ErrFeatureActivationHeightPassed(curParam.Features[1].Name).Result(),
+			wantCreatorBalance: c460000,
+			wantOngoingCount:   0,
+		},
+		{
+			testName:           "keep activated features unchanged",
+			parameter:          curParam,
+			wantRes:            wantRes,
+			wantCreatorBalance: c460000.Minus(proposalParam.ChangeParamMinDeposit),
+			wantOngoingCount:   1,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, NewChangeFeatureParamMsg(string(user1), tc.parameter, ""))
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+
+		creatorBalance, _ := am.GetSavingFromBank(ctx, user1)
+		if !creatorBalance.IsEqual(tc.wantCreatorBalance) {
+			t.Errorf("%s: diff bank balance: got %v, want %v", tc.testName, creatorBalance, tc.wantCreatorBalance)
+		}
+
+		ongoingList, err := proposalManager.GetOngoingProposalList(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal list, get err %v", tc.testName, err)
+		}
+		if len(ongoingList) != tc.wantOngoingCount {
+			t.Errorf("%s: diff ongoing proposal count, got %v, want %v", tc.testName, len(ongoingList), tc.wantOngoingCount)
+		}
+	}
+}
+
 func TestContentCensorshipProposal(t *testing.T) {
 	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
 	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
@@ -140,6 +208,8 @@ func TestContentCensorshipProposal(t *testing.T) {
 		ctx, am, "user3", proposalParam.ContentCensorshipMinDeposit.Minus(types.NewCoinFromInt64((1))))
 	postManager.DeletePost(ctx, types.GetPermlink(user2, postID2))
 	censorshipReason := "reason"
+	revisionHash, err := postManager.GetPostRevisionHash(ctx, types.GetPermlink(user1, postID1), 1)
+	assert.Nil(t, err)
 	proposal1 := &model.ContentCensorshipProposal{
 		ProposalInfo: model.ProposalInfo{
 			Creator:       user2,
@@ -150,13 +220,19 @@ func TestContentCensorshipProposal(t *testing.T) {
 			CreatedAt:     curTime,
 			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
 		},
-		Permlink: types.GetPermlink(user1, postID1),
-		Reason:   censorshipReason}
+		Permlink:     types.GetPermlink(user1, postID1),
+		Reason:       censorshipReason,
+		Revision:     1,
+		RevisionHash: revisionHash}
+	wantRes := sdk.Result{Tags: types.NewProposalTags(user2, proposalID1).
+		AppendTag(types.TagPermlink, string(types.GetPermlink(user1, postID1))).AppendTags(
+		types.NewTransferTags("", "", proposalParam.ContentCensorshipMinDeposit, types.ProposalDeposit))}
 
 	testCases := []struct {
 		testName            string
 		creator             types.AccountKey
 		permlink            types.Permlink
+		revision            int64
 		proposalID          types.ProposalKey
 		wantOK              bool
 		wantRes             sdk.Result
@@ -170,7 +246,7 @@ func TestContentCensorshipProposal(t *testing.T) {
 			permlink:            types.GetPermlink(user1, postID1),
 			proposalID:          proposalID1,
 			wantOK:              true,
-			wantRes:             sdk.Result{},
+			wantRes:             wantRes,
 			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
 			wantOngoingProposal: []model.Proposal{proposal1},
 			wantProposal:        proposal1,
@@ -197,6 +273,18 @@ func TestContentCensorshipProposal(t *testing.T) {
 			wantOngoingProposal: []model.Proposal{proposal1},
 			wantProposal:        proposal1,
 		},
+		{
+			testName:            "target revision is not exist",
+			creator:             user2,
+			permlink:            types.GetPermlink(user1, postID1),
+			revision:            2,
+			proposalID:          proposalID1,
+			wantOK:              false,
+			wantRes:             ErrInvalidCensorshipRevision(2).Result(),
+			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
+			wantOngoingProposal: []model.Proposal{proposal1},
+			wantProposal:        proposal1,
+		},
 		{
 			testName:            "proposal is invalid",
 			creator:             "invalid",
@@ -222,6 +310,7 @@ func TestContentCensorshipProposal(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		msg := NewDeletePostContentMsg(string(tc.creator), tc.permlink, censorshipReason)
+		msg.Revision = tc.revision
 		result := handler(ctx, msg)
 		if !assert.Equal(t, tc.wantRes, result) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
@@ -404,7 +493,7 @@ func TestVoteProposalBasic(t *testing.T) {
 				ProposalID: proposalID1,
 				Result:     true,
 			},
-			wantRes: sdk.Result{},
+			wantRes: sdk.Result{Tags: types.NewProposalTags(user1, proposalID1)},
 			wantOK:  true,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
@@ -460,3 +549,86 @@ func TestVoteProposalBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestVoteProposalDelegatorOverride(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	// user2 delegates c46 to user1
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	createTestAccount(ctx, am, "user1", c4600)
+	createTestAccount(ctx, am, "user2", c4600)
+	_ = vm.AddVoter(ctx, user1, c4600)
+	_ = vm.AddVoter(ctx, user2, c4600)
+	_ = vm.AddDelegation(ctx, user1, user2, c46)
+
+	decideSec := int64(100)
+	proposalID1, _ := proposalManager.AddProposal(ctx, user1, &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"), Reason: "reason"}, decideSec)
+	proposalID2, _ := proposalManager.AddProposal(ctx, user1, &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"), Reason: "reason"}, decideSec)
+
+	testCases := []struct {
+		testName          string
+		msg               VoteProposalMsg
+		wantAgreeVotes    types.Coin
+		wantDisagreeVotes types.Coin
+		wantVoterPower    types.Coin
+	}{
+		{
+			testName:          "voter votes with delegated power",
+			msg:               NewVoteProposalMsg("user1", proposalID1, true),
+			wantAgreeVotes:    c4600.Plus(c46),
+			wantDisagreeVotes: types.NewCoinFromInt64(0),
+			wantVoterPower:    c4600.Plus(c46),
+		},
+		{
+			testName:          "delegator overrides voter's vote",
+			msg:               NewVoteProposalMsg("user2", proposalID1, false),
+			wantAgreeVotes:    c4600,
+			wantDisagreeVotes: c4600,
+			wantVoterPower:    c4600,
+		},
+		{
+			testName:          "delegator votes before voter",
+			msg:               NewVoteProposalMsg("user2", proposalID2, false),
+			wantAgreeVotes:    types.NewCoinFromInt64(0),
+			wantDisagreeVotes: c4600,
+			wantVoterPower:    c4600,
+		},
+		{
+			testName:          "voter votes without overridden power",
+			msg:               NewVoteProposalMsg("user1", proposalID2, true),
+			wantAgreeVotes:    c4600,
+			wantDisagreeVotes: c4600,
+			wantVoterPower:    c4600,
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, sdk.Result{Tags: types.NewProposalTags(tc.msg.Voter, tc.msg.ProposalID)}, result) {
+			t.Errorf("%s: diff result, got %v", tc.testName, result)
+		}
+		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, tc.msg.ProposalID)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
+			continue
+		}
+		info := proposal.GetProposalInfo()
+		if !info.AgreeVotes.IsEqual(tc.wantAgreeVotes) {
+			t.Errorf("%s: diff agree votes, got %v, want %v", tc.testName, info.AgreeVotes, tc.wantAgreeVotes)
+		}
+		if !info.DisagreeVotes.IsEqual(tc.wantDisagreeVotes) {
+			t.Errorf("%s: diff disagree votes, got %v, want %v", tc.testName, info.DisagreeVotes, tc.wantDisagreeVotes)
+		}
+		vote, err := vm.GetVote(ctx, tc.msg.ProposalID, user1)
+		if err != nil {
+			continue
+		}
+		if !vote.VotingPower.IsEqual(tc.wantVoterPower) {
+			t.Errorf("%s: diff voter power, got %v, want %v", tc.testName, vote.VotingPower, tc.wantVoterPower)
+		}
+	}
+}
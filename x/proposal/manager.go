@@ -45,18 +45,24 @@ func (pm ProposalManager) IsOngoingProposal(ctx sdk.Context, proposalID types.Pr
 
 // CreateContentCensorshipProposal - create a content censorship proposal
 func (pm ProposalManager) CreateContentCensorshipProposal(
-	ctx sdk.Context, permlink types.Permlink, reason string) model.Proposal {
+	ctx sdk.Context, permlink types.Permlink, revision int64, revisionHash string,
+	reason string) model.Proposal {
 	return &model.ContentCensorshipProposal{
-		Permlink: permlink,
-		Reason:   reason,
+		Permlink:     permlink,
+		Reason:       reason,
+		Revision:     revision,
+		RevisionHash: revisionHash,
 	}
 }
 
 // CreateProtocolUpgradeProposal - create a protocol upgrade proposal
-func (pm ProposalManager) CreateProtocolUpgradeProposal(ctx sdk.Context, link string, reason string) model.Proposal {
+func (pm ProposalManager) CreateProtocolUpgradeProposal(
+	ctx sdk.Context, link string, name string, height int64, reason string) model.Proposal {
 	return &model.ProtocolUpgradeProposal{
 		Link:   link,
 		Reason: reason,
+		Name:   name,
+		Height: height,
 	}
 }
 
@@ -166,6 +172,29 @@ func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID
 	return nil
 }
 
+// DeductProposalVotes - deduct voting power from a vote result, used when a delegator
+// overrides the vote of its voter after the voter voted
+func (pm ProposalManager) DeductProposalVotes(ctx sdk.Context, proposalID types.ProposalKey,
+	voteResult bool, votingPower types.Coin) sdk.Error {
+	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+
+	if voteResult {
+		proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Minus(votingPower)
+	} else {
+		proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Minus(votingPower)
+	}
+
+	proposal.SetProposalInfo(proposalInfo)
+	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+	return nil
+}
+
 // UpdateProposalPassStatus - update proposal pass status when proposal change from ongoing to expired
 func (pm ProposalManager) UpdateProposalPassStatus(
 	ctx sdk.Context, proposalType types.ProposalType,
@@ -185,18 +214,11 @@ func (pm ProposalManager) UpdateProposalStatus(
 	proposalInfo := proposal.GetProposalInfo()
 
 	// calculate if agree votes meet minimum pass requirement
-	ratio, minVotes, err := pm.GetProposalPassParam(ctx, proposalType)
+	result, err := pm.CalculateProposalResult(ctx, proposalType, proposalInfo)
 	if err != nil {
 		return types.ProposalNotPass, err
 	}
-	totalVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
-	actualRatio := proposalInfo.AgreeVotes.ToDec().Quo(totalVotes.ToDec())
-
-	if !totalVotes.IsGT(minVotes) || !ratio.LT(actualRatio) {
-		proposalInfo.Result = types.ProposalNotPass
-	} else {
-		proposalInfo.Result = types.ProposalPass
-	}
+	proposalInfo.Result = result
 
 	proposal.SetProposalInfo(proposalInfo)
 	if err := pm.storage.SetExpiredProposal(ctx, proposalID, proposal); err != nil {
@@ -209,6 +231,62 @@ func (pm ProposalManager) UpdateProposalStatus(
 	return proposalInfo.Result, nil
 }
 
+// CalculateProposalResult - decide proposal result based on current votes and pass requirement
+func (pm ProposalManager) CalculateProposalResult(
+	ctx sdk.Context, proposalType types.ProposalType, proposalInfo model.ProposalInfo) (types.ProposalResult, sdk.Error) {
+	ratio, minVotes, err := pm.GetProposalPassParam(ctx, proposalType)
+	if err != nil {
+		return types.ProposalNotPass, err
+	}
+	votesMet, ratioMet := isPassRequirementMet(proposalInfo, ratio, minVotes)
+	if !votesMet || !ratioMet {
+		return types.ProposalNotPass, nil
+	}
+	return types.ProposalPass, nil
+}
+
+// GetProposalOutcome - get projected outcome of an ongoing proposal if it was decided now,
+// voting power out of @p totalVotingPower not counted in the votes yet is outstanding.
+func (pm ProposalManager) GetProposalOutcome(
+	ctx sdk.Context, proposalID types.ProposalKey, totalVotingPower types.Coin) (*model.ProposalOutcome, sdk.Error) {
+	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	proposalType, err := getProposalType(proposal)
+	if err != nil {
+		return nil, err
+	}
+	ratio, minVotes, err := pm.GetProposalPassParam(ctx, proposalType)
+	if err != nil {
+		return nil, err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+	votesMet, ratioMet := isPassRequirementMet(proposalInfo, ratio, minVotes)
+	result, err := pm.CalculateProposalResult(ctx, proposalType, proposalInfo)
+	if err != nil {
+		return nil, err
+	}
+	// votes are counted with voting power at vote time, which may be withdrawn since.
+	outstandingVotes := types.NewCoinFromInt64(0)
+	if countedVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes); totalVotingPower.IsGT(countedVotes) {
+		outstandingVotes = totalVotingPower.Minus(countedVotes)
+	}
+	return &model.ProposalOutcome{
+		ProposalID:       proposalID,
+		ProposalType:     proposalType,
+		AgreeVotes:       proposalInfo.AgreeVotes,
+		DisagreeVotes:    proposalInfo.DisagreeVotes,
+		OutstandingVotes: outstandingVotes,
+		PassRatio:        ratio,
+		PassVotes:        minVotes,
+		PassRatioMet:     ratioMet,
+		PassVotesMet:     votesMet,
+		Result:           result,
+		ExpiredAt:        proposalInfo.ExpiredAt,
+	}, nil
+}
+
 // CreateDecideProposalEvent - create a decide proposal event
 func (pm ProposalManager) CreateDecideProposalEvent(
 	ctx sdk.Context, proposalType types.ProposalType, proposalID types.ProposalKey) types.Event {
@@ -252,7 +330,94 @@ func (pm ProposalManager) GetPermlink(ctx sdk.Context, proposalID types.Proposal
 	return p.Permlink, nil
 }
 
+// CreateUpgradePlan - create an upgrade plan from expired protocol upgrade proposal
+func (pm ProposalManager) CreateUpgradePlan(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.UpgradePlan, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.ProtocolUpgradeProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return &model.UpgradePlan{
+		ProposalID: proposalID,
+		Name:       p.Name,
+		Height:     p.Height,
+		Link:       p.Link,
+	}, nil
+}
+
+// DoesUpgradePlanExist - check if an upgrade plan is pending
+func (pm ProposalManager) DoesUpgradePlanExist(ctx sdk.Context) bool {
+	return pm.storage.DoesUpgradePlanExist(ctx)
+}
+
+// GetUpgradePlan - get pending upgrade plan
+func (pm ProposalManager) GetUpgradePlan(ctx sdk.Context) (*model.UpgradePlan, sdk.Error) {
+	return pm.storage.GetUpgradePlan(ctx)
+}
+
+// SetUpgradePlan - schedule an upgrade plan, the previous pending plan is replaced
+func (pm ProposalManager) SetUpgradePlan(ctx sdk.Context, plan *model.UpgradePlan) sdk.Error {
+	return pm.storage.SetUpgradePlan(ctx, plan)
+}
+
+// DeleteUpgradePlan - remove pending upgrade plan once it's applied
+func (pm ProposalManager) DeleteUpgradePlan(ctx sdk.Context) {
+	pm.storage.DeleteUpgradePlan(ctx)
+}
+
 // GetOngoingProposalList - get ongoing proposal list
 func (pm ProposalManager) GetOngoingProposalList(ctx sdk.Context) ([]model.Proposal, sdk.Error) {
 	return pm.storage.GetOngoingProposalList(ctx)
 }
+
+// Export state of proposals.
+func (pm ProposalManager) Export(ctx sdk.Context) *model.ProposalTables {
+	return pm.storage.Export(ctx)
+}
+
+// Import state of proposals.
+func (pm ProposalManager) Import(ctx sdk.Context, tb *model.ProposalTablesIR) {
+	pm.storage.Import(ctx, tb)
+}
+
+// ExportRows - stream state of proposals row by row.
+func (pm ProposalManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	pm.storage.ExportRows(ctx, write)
+}
+
+// ImportRow - import state of proposals row by row.
+func (pm ProposalManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	pm.storage.ImportRow(ctx, table, read)
+}
+
+// isPassRequirementMet - total votes must be greater than pass votes and
+// agree ratio must be greater than pass ratio.
+func isPassRequirementMet(
+	proposalInfo model.ProposalInfo, ratio sdk.Dec, minVotes types.Coin) (votesMet bool, ratioMet bool) {
+	totalVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
+	votesMet = totalVotes.IsGT(minVotes)
+	if totalVotes.IsPositive() {
+		actualRatio := proposalInfo.AgreeVotes.ToDec().Quo(totalVotes.ToDec())
+		ratioMet = ratio.LT(actualRatio)
+	}
+	return votesMet, ratioMet
+}
+
+// getProposalType - get proposal type of a proposal
+func getProposalType(proposal model.Proposal) (types.ProposalType, sdk.Error) {
+	switch proposal.(type) {
+	case *model.ChangeParamProposal:
+		return types.ChangeParam, nil
+	case *model.ContentCensorshipProposal:
+		return types.ContentCensorship, nil
+	case *model.ProtocolUpgradeProposal:
+		return types.ProtocolUpgrade, nil
+	default:
+		return types.ChangeParam, ErrIncorrectProposalType()
+	}
+}
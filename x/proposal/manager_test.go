@@ -293,3 +293,109 @@ func TestGetProposalPassParam(t *testing.T) {
 	}
 
 }
+
+func TestGetProposalOutcome(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	user1 := types.AccountKey("user1")
+	pm.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	decideSec := proposalParam.ContentCensorshipDecideSec
+	passVotes := proposalParam.ContentCensorshipPassVotes
+	totalVotingPower := passVotes.Plus(passVotes).Plus(types.NewCoinFromInt64(100))
+
+	proposalID, _ := pm.AddProposal(ctx, user1, &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("permlink"),
+		Reason:   "reason",
+	}, decideSec)
+
+	testCases := []struct {
+		testName        string
+		agreeVotes      types.Coin
+		disagreeVotes   types.Coin
+		wantVotesMet    bool
+		wantRatioMet    bool
+		wantResult      types.ProposalResult
+		wantOutstanding types.Coin
+	}{
+		{
+			testName:        "no votes",
+			agreeVotes:      types.NewCoinFromInt64(0),
+			disagreeVotes:   types.NewCoinFromInt64(0),
+			wantVotesMet:    false,
+			wantRatioMet:    false,
+			wantResult:      types.ProposalNotPass,
+			wantOutstanding: totalVotingPower,
+		},
+		{
+			testName:        "votes don't meet min requirement",
+			agreeVotes:      passVotes.Minus(types.NewCoinFromInt64(10)),
+			disagreeVotes:   types.NewCoinFromInt64(0),
+			wantVotesMet:    false,
+			wantRatioMet:    true,
+			wantResult:      types.ProposalNotPass,
+			wantOutstanding: passVotes.Plus(types.NewCoinFromInt64(110)),
+		},
+		{
+			testName:        "votes ratio doesn't meet requirement",
+			agreeVotes:      passVotes,
+			disagreeVotes:   passVotes,
+			wantVotesMet:    true,
+			wantRatioMet:    false,
+			wantResult:      types.ProposalNotPass,
+			wantOutstanding: types.NewCoinFromInt64(100),
+		},
+		{
+			testName:        "proposal is projected to pass",
+			agreeVotes:      passVotes.Plus(types.NewCoinFromInt64(10)),
+			disagreeVotes:   types.NewCoinFromInt64(0),
+			wantVotesMet:    true,
+			wantRatioMet:    true,
+			wantResult:      types.ProposalPass,
+			wantOutstanding: passVotes.Plus(types.NewCoinFromInt64(90)),
+		},
+		{
+			testName:        "votes counted with power withdrawn since",
+			agreeVotes:      totalVotingPower,
+			disagreeVotes:   types.NewCoinFromInt64(10),
+			wantVotesMet:    true,
+			wantRatioMet:    true,
+			wantResult:      types.ProposalPass,
+			wantOutstanding: types.NewCoinFromInt64(0),
+		},
+	}
+	for _, tc := range testCases {
+		err := addProposalInfo(ctx, pm, proposalID, tc.agreeVotes, tc.disagreeVotes)
+		if err != nil {
+			t.Errorf("%s: failed to add proposal info, got err %v", tc.testName, err)
+		}
+
+		outcome, err := pm.GetProposalOutcome(ctx, proposalID, totalVotingPower)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal outcome, got err %v", tc.testName, err)
+			continue
+		}
+		wantOutcome := &model.ProposalOutcome{
+			ProposalID:       proposalID,
+			ProposalType:     types.ContentCensorship,
+			AgreeVotes:       tc.agreeVotes,
+			DisagreeVotes:    tc.disagreeVotes,
+			OutstandingVotes: tc.wantOutstanding,
+			PassRatio:        proposalParam.ContentCensorshipPassRatio,
+			PassVotes:        passVotes,
+			PassVotesMet:     tc.wantVotesMet,
+			PassRatioMet:     tc.wantRatioMet,
+			Result:           tc.wantResult,
+			ExpiredAt:        curTime + decideSec,
+		}
+		if !assert.Equal(t, wantOutcome, outcome) {
+			t.Errorf("%s: diff outcome, got %v, want %v", tc.testName, outcome, wantOutcome)
+		}
+	}
+
+	// outcome is only projected for ongoing proposals
+	_, err := pm.UpdateProposalPassStatus(ctx, types.ContentCensorship, proposalID)
+	assert.Nil(t, err)
+	_, err = pm.GetProposalOutcome(ctx, proposalID, totalVotingPower)
+	assert.NotNil(t, err)
+}
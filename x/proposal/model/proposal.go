@@ -3,6 +3,8 @@ package model
 import (
 	"github.com/lino-network/lino/param"
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Proposal - there are three proposal types
@@ -40,10 +42,13 @@ func (p *ChangeParamProposal) GetProposalInfo() ProposalInfo { return p.Proposal
 func (p *ChangeParamProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
 // ContentCensorshipProposal - content censorship proposal
+// Revision and RevisionHash identify the post content the proposal refers to.
 type ContentCensorshipProposal struct {
 	ProposalInfo
-	Permlink types.Permlink `json:"permlink"`
-	Reason   string         `json:"reason"`
+	Permlink     types.Permlink `json:"permlink"`
+	Reason       string         `json:"reason"`
+	Revision     int64          `json:"revision"`
+	RevisionHash string         `json:"revision_hash"`
 }
 
 // GetProposalInfo - implements Proposal
@@ -53,10 +58,13 @@ func (p *ContentCensorshipProposal) GetProposalInfo() ProposalInfo { return p.Pr
 func (p *ContentCensorshipProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
 // ProtocolUpgradeProposal - protocol upgrade proposal
+// Name and Height are the upgrade plan scheduled once the proposal is passed.
 type ProtocolUpgradeProposal struct {
 	ProposalInfo
 	Link   string `json:"link"`
 	Reason string `json:"reason"`
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
 }
 
 // GetProposalInfo - implements Proposal
@@ -65,7 +73,32 @@ func (p *ProtocolUpgradeProposal) GetProposalInfo() ProposalInfo { return p.Prop
 // SetProposalInfo - implements Proposal
 func (p *ProtocolUpgradeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
+// UpgradePlan - protocol upgrade scheduled by a passed protocol upgrade proposal,
+// the chain halts at Height until a binary implementing upgrade Name takes over.
+type UpgradePlan struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Name       string            `json:"name"`
+	Height     int64             `json:"height"`
+	Link       string            `json:"link"`
+}
+
 // NextProposalID - store next proposal ID to KVStore
 type NextProposalID struct {
 	NextProposalID int64 `json:"next_proposal_id"`
 }
+
+// ProposalOutcome - projected result of an ongoing proposal if it was decided
+// with current votes, OutstandingVotes is voting power of voters who haven't voted.
+type ProposalOutcome struct {
+	ProposalID       types.ProposalKey    `json:"proposal_id"`
+	ProposalType     types.ProposalType   `json:"proposal_type"`
+	AgreeVotes       types.Coin           `json:"agree_votes"`
+	DisagreeVotes    types.Coin           `json:"disagree_votes"`
+	OutstandingVotes types.Coin           `json:"outstanding_votes"`
+	PassRatio        sdk.Dec              `json:"pass_ratio"`
+	PassVotes        types.Coin           `json:"pass_votes"`
+	PassRatioMet     bool                 `json:"pass_ratio_met"`
+	PassVotesMet     bool                 `json:"pass_votes_met"`
+	Result           types.ProposalResult `json:"result"`
+	ExpiredAt        int64                `json:"expired_at"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// table names of proposal storage, used in streaming export and import.
+const (
+	OngoingProposalTable = "ongoing_proposals"
+	ExpiredProposalTable = "expired_proposals"
+	NextProposalIDTable  = "next_proposal_id"
+	UpgradePlanTable     = "upgrade_plan"
+)
+
+// ProposalRow - proposal, pk: proposalID
+type ProposalRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Proposal   Proposal          `json:"proposal"`
+}
+
+// NextProposalIDRow - next proposal ID, pk: none.
+type NextProposalIDRow struct {
+	NextProposalID NextProposalID `json:"next_proposal_id"`
+}
+
+// UpgradePlanRow - pending upgrade plan, pk: none.
+type UpgradePlanRow struct {
+	UpgradePlan UpgradePlan `json:"upgrade_plan"`
+}
+
+// ProposalTables proposal storage state
+type ProposalTables struct {
+	OngoingProposals []ProposalRow     `json:"ongoing_proposals"`
+	ExpiredProposals []ProposalRow     `json:"expired_proposals"`
+	NextProposalID   NextProposalIDRow `json:"next_proposal_id"`
+	UpgradePlans     []UpgradePlanRow  `json:"upgrade_plans"`
+}
+
+// ToIR - same
+func (p ProposalTables) ToIR() ProposalTablesIR {
+	return p
+}
@@ -11,6 +11,7 @@ var (
 	nextProposalIDSubstore  = []byte{0x00}
 	ongoingProposalSubStore = []byte{0x01}
 	expiredProposalSubStore = []byte{0x02}
+	upgradePlanSubStore     = []byte{0x03}
 )
 
 // ProposalStorage - proposal storage
@@ -21,7 +22,18 @@ type ProposalStorage struct {
 
 func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc := wire.New()
+	RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+	vs := ProposalStorage{
+		key: key,
+		cdc: cdc,
+	}
+	return vs
+}
 
+// RegisterWire - register proposal and parameter types, also used by the
+// app codec so that exported proposals can be encoded.
+func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&ChangeParamProposal{}, "changeParam", nil)
 	cdc.RegisterConcrete(&ProtocolUpgradeProposal{}, "upgrade", nil)
@@ -38,13 +50,8 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "bandwidthParam", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "accountParam", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "postParam", nil)
-
-	wire.RegisterCrypto(cdc)
-	vs := ProposalStorage{
-		key: key,
-		cdc: cdc,
-	}
-	return vs
+	cdc.RegisterConcrete(param.InfraParam{}, "infraParam", nil)
+	cdc.RegisterConcrete(param.FeatureParam{}, "featureParam", nil)
 }
 
 // InitGenesis - initialize proposal storage
@@ -193,6 +200,170 @@ func (ps ProposalStorage) SetNextProposalID(ctx sdk.Context, nextProposalID *Nex
 	return nil
 }
 
+// DoesUpgradePlanExist - check if an upgrade plan is pending
+func (ps ProposalStorage) DoesUpgradePlanExist(ctx sdk.Context) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(getUpgradePlanKey())
+}
+
+// GetUpgradePlan - get pending upgrade plan from KVStore
+func (ps ProposalStorage) GetUpgradePlan(ctx sdk.Context) (*UpgradePlan, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	planByte := store.Get(getUpgradePlanKey())
+	if planByte == nil {
+		return nil, ErrUpgradePlanNotFound()
+	}
+	plan := new(UpgradePlan)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(planByte, plan); err != nil {
+		return nil, ErrFailedToUnmarshalUpgradePlan(err)
+	}
+	return plan, nil
+}
+
+// SetUpgradePlan - set pending upgrade plan to KVStore, replaces the previous one
+func (ps ProposalStorage) SetUpgradePlan(ctx sdk.Context, plan *UpgradePlan) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	planByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*plan)
+	if err != nil {
+		return ErrFailedToMarshalUpgradePlan(err)
+	}
+	store.Set(getUpgradePlanKey(), planByte)
+	return nil
+}
+
+// DeleteUpgradePlan - delete pending upgrade plan from KVStore
+func (ps ProposalStorage) DeleteUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getUpgradePlanKey())
+}
+
+// Export - proposal state
+func (ps ProposalStorage) Export(ctx sdk.Context) *ProposalTables {
+	tables := &ProposalTables{}
+	ps.iterateProposalRows(ctx, ongoingProposalSubStore, func(row ProposalRow) {
+		tables.OngoingProposals = append(tables.OngoingProposals, row)
+	})
+	ps.iterateProposalRows(ctx, expiredProposalSubStore, func(row ProposalRow) {
+		tables.ExpiredProposals = append(tables.ExpiredProposals, row)
+	})
+	tables.NextProposalID = ps.nextProposalIDRow(ctx)
+	ps.iterateUpgradePlanRows(ctx, func(row UpgradePlanRow) {
+		tables.UpgradePlans = append(tables.UpgradePlans, row)
+	})
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (ps ProposalStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	ps.iterateProposalRows(ctx, ongoingProposalSubStore, func(row ProposalRow) {
+		write(OngoingProposalTable, row)
+	})
+	ps.iterateProposalRows(ctx, expiredProposalSubStore, func(row ProposalRow) {
+		write(ExpiredProposalTable, row)
+	})
+	write(NextProposalIDTable, ps.nextProposalIDRow(ctx))
+	ps.iterateUpgradePlanRows(ctx, func(row UpgradePlanRow) {
+		write(UpgradePlanTable, row)
+	})
+}
+
+func (ps ProposalStorage) iterateProposalRows(
+	ctx sdk.Context, prefix []byte, process func(ProposalRow)) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, prefix)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var p Proposal
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &p); err != nil {
+			panic("failed to read proposal: " + err.Error())
+		}
+		process(ProposalRow{
+			ProposalID: types.ProposalKey(itr.Key()[1:]),
+			Proposal:   p,
+		})
+	}
+}
+
+// iterateUpgradePlanRows - process the pending upgrade plan, if any.
+func (ps ProposalStorage) iterateUpgradePlanRows(ctx sdk.Context, process func(UpgradePlanRow)) {
+	if !ps.DoesUpgradePlanExist(ctx) {
+		return
+	}
+	plan, err := ps.GetUpgradePlan(ctx)
+	if err != nil {
+		panic("failed to get upgrade plan: " + err.Error())
+	}
+	process(UpgradePlanRow{
+		UpgradePlan: *plan,
+	})
+}
+
+func (ps ProposalStorage) nextProposalIDRow(ctx sdk.Context) NextProposalIDRow {
+	id, err := ps.GetNextProposalID(ctx)
+	if err != nil {
+		panic("failed to get next proposal id: " + err.Error())
+	}
+	return NextProposalIDRow{
+		NextProposalID: *id,
+	}
+}
+
+// Import from tablesIR.
+func (ps ProposalStorage) Import(ctx sdk.Context, tb *ProposalTablesIR) {
+	check := func(e error) {
+		if e != nil {
+			panic("[ps] Failed to import: " + e.Error())
+		}
+	}
+	// import table.OngoingProposals
+	for _, v := range tb.OngoingProposals {
+		err := ps.SetOngoingProposal(ctx, v.ProposalID, v.Proposal)
+		check(err)
+	}
+	// import table.ExpiredProposals
+	for _, v := range tb.ExpiredProposals {
+		err := ps.SetExpiredProposal(ctx, v.ProposalID, v.Proposal)
+		check(err)
+	}
+	// import NextProposalID
+	err := ps.SetNextProposalID(ctx, &tb.NextProposalID.NextProposalID)
+	check(err)
+	// import table.UpgradePlans
+	for _, v := range tb.UpgradePlans {
+		err := ps.SetUpgradePlan(ctx, &v.UpgradePlan)
+		check(err)
+	}
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (ps ProposalStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[ps] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case OngoingProposalTable:
+		row := ProposalRow{}
+		check(read(&row))
+		check(ps.SetOngoingProposal(ctx, row.ProposalID, row.Proposal))
+	case ExpiredProposalTable:
+		row := ProposalRow{}
+		check(read(&row))
+		check(ps.SetExpiredProposal(ctx, row.ProposalID, row.Proposal))
+	case NextProposalIDTable:
+		row := NextProposalIDRow{}
+		check(read(&row))
+		check(ps.SetNextProposalID(ctx, &row.NextProposalID))
+	case UpgradePlanTable:
+		row := UpgradePlanRow{}
+		check(read(&row))
+		check(ps.SetUpgradePlan(ctx, &row.UpgradePlan))
+	default:
+		panic("[ps] Failed to import: unknown table " + table)
+	}
+}
+
 // GetOngoingProposalKey - "ongoing proposal substore" + "proposal ID"
 func GetOngoingProposalKey(proposalID types.ProposalKey) []byte {
 	return append(ongoingProposalSubStore, proposalID...)
@@ -207,6 +378,10 @@ func getNextProposalIDKey() []byte {
 	return nextProposalIDSubstore
 }
 
+func getUpgradePlanKey() []byte {
+	return upgradePlanSubStore
+}
+
 // XXX(yumin): a overflow bug if end = 255
 func subspace(prefix []byte) (start, end []byte) {
 	end = make([]byte, len(prefix))
@@ -171,3 +171,109 @@ func TestNextProposalID(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, nextProposalID, id)
 }
+
+func TestExportImportRows(t *testing.T) {
+	ctx, ps := setup(t)
+
+	ongoing := &ChangeParamProposal{
+		ProposalInfo: ProposalInfo{
+			Creator:       types.AccountKey("user"),
+			ProposalID:    types.ProposalKey("2"),
+			AgreeVotes:    types.NewCoinFromInt64(10),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+		},
+		Param: param.GlobalAllocationParam{
+			GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
+			InfraAllocation:          sdk.NewDec(0),
+			ContentCreatorAllocation: sdk.NewDec(0),
+			DeveloperAllocation:      sdk.NewDec(0),
+			ValidatorAllocation:      sdk.NewDec(1),
+		},
+		Reason: "reason",
+	}
+	expired := &ContentCensorshipProposal{
+		ProposalInfo: ProposalInfo{
+			Creator:       types.AccountKey("user"),
+			ProposalID:    types.ProposalKey("1"),
+			AgreeVotes:    types.NewCoinFromInt64(5),
+			DisagreeVotes: types.NewCoinFromInt64(6),
+			Result:        types.ProposalNotPass,
+		},
+		Permlink: types.Permlink("permlink"),
+	}
+	assert.Nil(t, ps.SetOngoingProposal(ctx, types.ProposalKey("2"), ongoing))
+	assert.Nil(t, ps.SetExpiredProposal(ctx, types.ProposalKey("1"), expired))
+	assert.Nil(t, ps.SetNextProposalID(ctx, &NextProposalID{3}))
+
+	type row struct {
+		table string
+		bytes []byte
+	}
+	rows := []row{}
+	ps.ExportRows(ctx, func(table string, r interface{}) {
+		bz, err := ps.cdc.MarshalJSON(r)
+		assert.Nil(t, err)
+		rows = append(rows, row{table: table, bytes: bz})
+	})
+	assert.Equal(t, 3, len(rows))
+	assert.Equal(t, OngoingProposalTable, rows[0].table)
+	assert.Equal(t, ExpiredProposalTable, rows[1].table)
+	assert.Equal(t, NextProposalIDTable, rows[2].table)
+
+	ctx, ps = setup(t)
+	for _, r := range rows {
+		bz := r.bytes
+		ps.ImportRow(ctx, r.table, func(v interface{}) error {
+			return ps.cdc.UnmarshalJSON(bz, v)
+		})
+	}
+	p, err := ps.GetOngoingProposal(ctx, types.ProposalKey("2"))
+	assert.Nil(t, err)
+	assert.Equal(t, ongoing, p)
+	p, err = ps.GetExpiredProposal(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, expired, p)
+	id, err := ps.GetNextProposalID(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, NextProposalID{3}, *id)
+}
+
+func TestUpgradePlan(t *testing.T) {
+	ctx, ps := setup(t)
+
+	assert.False(t, ps.DoesUpgradePlanExist(ctx))
+	_, err := ps.GetUpgradePlan(ctx)
+	assert.Equal(t, ErrUpgradePlanNotFound(), err)
+
+	plan := &UpgradePlan{
+		ProposalID: types.ProposalKey("1"),
+		Name:       "upgrade2",
+		Height:     100,
+		Link:       "link",
+	}
+	assert.Nil(t, ps.SetUpgradePlan(ctx, plan))
+	assert.True(t, ps.DoesUpgradePlanExist(ctx))
+	p, err := ps.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, plan, p)
+
+	rows := map[string][]byte{}
+	ps.ExportRows(ctx, func(table string, r interface{}) {
+		bz, err := ps.cdc.MarshalJSON(r)
+		assert.Nil(t, err)
+		rows[table] = bz
+	})
+	bz, ok := rows[UpgradePlanTable]
+	assert.True(t, ok)
+
+	ctx, ps = setup(t)
+	ps.ImportRow(ctx, UpgradePlanTable, func(v interface{}) error {
+		return ps.cdc.UnmarshalJSON(bz, v)
+	})
+	p, err = ps.GetUpgradePlan(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, plan, p)
+
+	ps.DeleteUpgradePlan(ctx)
+	assert.False(t, ps.DoesUpgradePlanExist(ctx))
+}
@@ -21,6 +21,8 @@ var _ types.Msg = ChangeValidatorParamMsg{}
 var _ types.Msg = ChangeBandwidthParamMsg{}
 var _ types.Msg = ChangeAccountParamMsg{}
 var _ types.Msg = ChangePostParamMsg{}
+var _ types.Msg = ChangeInfraParamMsg{}
+var _ types.Msg = ChangeFeatureParamMsg{}
 var _ types.Msg = VoteProposalMsg{}
 
 var _ ChangeParamMsg = ChangeGlobalAllocationParamMsg{}
@@ -32,6 +34,8 @@ var _ ChangeParamMsg = ChangeValidatorParamMsg{}
 var _ ChangeParamMsg = ChangeBandwidthParamMsg{}
 var _ ChangeParamMsg = ChangeAccountParamMsg{}
 var _ ChangeParamMsg = ChangePostParamMsg{}
+var _ ChangeParamMsg = ChangeInfraParamMsg{}
+var _ ChangeParamMsg = ChangeFeatureParamMsg{}
 
 var _ ContentCensorshipMsg = DeletePostContentMsg{}
 
@@ -48,6 +52,7 @@ type ChangeParamMsg interface {
 type ContentCensorshipMsg interface {
 	GetCreator() types.AccountKey
 	GetPermlink() types.Permlink
+	GetRevision() int64
 	GetReason() string
 }
 
@@ -55,20 +60,28 @@ type ContentCensorshipMsg interface {
 type ProtocolUpgradeMsg interface {
 	GetCreator() types.AccountKey
 	GetLink() string
+	GetName() string
+	GetHeight() int64
 	GetReason() string
 }
 
 // DeletePostContentMsg - implement of content censorship msg
+// Revision - post revision the proposal refers to, 0 means the current revision
 type DeletePostContentMsg struct {
 	Creator  types.AccountKey `json:"creator"`
 	Permlink types.Permlink   `json:"permlink"`
+	Revision int64            `json:"revision,omitempty"`
 	Reason   string           `json:"reason"`
 }
 
 // UpgradeProtocolMsg - implement of protocol upgrade msg
+// Name - name of the upgrade the new binary implements
+// Height - block height the chain halts at for the upgrade
 type UpgradeProtocolMsg struct {
 	Creator types.AccountKey `json:"creator"`
 	Link    string           `json:"link"`
+	Name    string           `json:"name"`
+	Height  int64            `json:"height"`
 	Reason  string           `json:"reason"`
 }
 
@@ -135,6 +148,20 @@ type ChangePostParamMsg struct {
 	Reason    string           `json:"reason"`
 }
 
+// ChangeInfraParamMsg - implement of change parameter msg
+type ChangeInfraParamMsg struct {
+	Creator   types.AccountKey `json:"creator"`
+	Parameter param.InfraParam `json:"parameter"`
+	Reason    string           `json:"reason"`
+}
+
+// ChangeFeatureParamMsg - implement of change parameter msg
+type ChangeFeatureParamMsg struct {
+	Creator   types.AccountKey   `json:"creator"`
+	Parameter param.FeatureParam `json:"parameter"`
+	Reason    string             `json:"reason"`
+}
+
 // VoteProposalMsg - implement of change parameter msg
 type VoteProposalMsg struct {
 	Voter      types.AccountKey  `json:"voter"`
@@ -157,6 +184,9 @@ func NewDeletePostContentMsg(
 // GetPermlink - implement DeletePostContentMsg
 func (msg DeletePostContentMsg) GetPermlink() types.Permlink { return msg.Permlink }
 
+// GetRevision - implement DeletePostContentMsg
+func (msg DeletePostContentMsg) GetRevision() int64 { return msg.Revision }
+
 // GetCreator - implement DeletePostContentMsg
 func (msg DeletePostContentMsg) GetCreator() types.AccountKey { return msg.Creator }
 
@@ -178,6 +208,9 @@ func (msg DeletePostContentMsg) ValidateBasic() sdk.Error {
 	if len(msg.GetPermlink()) == 0 {
 		return ErrInvalidPermlink()
 	}
+	if msg.Revision < 0 {
+		return ErrInvalidCensorshipRevision(msg.Revision)
+	}
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
@@ -185,7 +218,8 @@ func (msg DeletePostContentMsg) ValidateBasic() sdk.Error {
 }
 
 func (msg DeletePostContentMsg) String() string {
-	return fmt.Sprintf("DeletePostContentMsg{Creator:%v, post:%v}", msg.Creator, msg.GetPermlink())
+	return fmt.Sprintf("DeletePostContentMsg{Creator:%v, post:%v, revision:%v}",
+		msg.Creator, msg.GetPermlink(), msg.Revision)
 }
 
 // GetPermission - implement types.Msg
@@ -216,10 +250,12 @@ func (msg DeletePostContentMsg) GetConsumeAmount() types.Coin {
 // UpgradeProtocolMsg Msg Implementations
 
 func NewUpgradeProtocolMsg(
-	creator, link, reason string) UpgradeProtocolMsg {
+	creator, link, name string, height int64, reason string) UpgradeProtocolMsg {
 	return UpgradeProtocolMsg{
 		Creator: types.AccountKey(creator),
 		Link:    link,
+		Name:    name,
+		Height:  height,
 		Reason:  reason,
 	}
 }
@@ -230,6 +266,12 @@ func (msg UpgradeProtocolMsg) GetCreator() types.AccountKey { return msg.Creator
 // GetLink - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetLink() string { return msg.Link }
 
+// GetName - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetName() string { return msg.Name }
+
+// GetHeight - implement UpgradeProtocolMsg
+func (msg UpgradeProtocolMsg) GetHeight() int64 { return msg.Height }
+
 // GetReason - implement UpgradeProtocolMsg
 func (msg UpgradeProtocolMsg) GetReason() string { return msg.Reason }
 
@@ -251,6 +293,12 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 	if len(msg.GetLink()) > types.MaximumLinkURL {
 		return ErrInvalidLink()
 	}
+	if len(msg.Name) == 0 || len(msg.Name) > types.MaximumLengthOfUpgradeName {
+		return ErrInvalidUpgradeName()
+	}
+	if msg.Height <= 0 {
+		return ErrInvalidUpgradeHeight(msg.Height)
+	}
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
@@ -258,7 +306,8 @@ func (msg UpgradeProtocolMsg) ValidateBasic() sdk.Error {
 }
 
 func (msg UpgradeProtocolMsg) String() string {
-	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Link:%v}", msg.Creator, msg.GetLink())
+	return fmt.Sprintf("UpgradeProtocolMsg{Creator:%v, Link:%v, Name:%v, Height:%v}",
+		msg.Creator, msg.GetLink(), msg.Name, msg.Height)
 }
 
 // GetPermission - implement types.Msg
@@ -815,7 +864,8 @@ func (msg ChangeAccountParamMsg) ValidateBasic() sdk.Error {
 	if !msg.Parameter.MinimumBalance.IsNotNegative() ||
 		!msg.Parameter.RegisterFee.IsNotNegative() ||
 		!msg.Parameter.FirstDepositFullCoinDayLimit.IsNotNegative() ||
-		msg.Parameter.MaxNumFrozenMoney <= 0 {
+		msg.Parameter.MaxNumFrozenMoney <= 0 ||
+		msg.Parameter.MaxNumBalanceHistory < 0 {
 		return ErrIllegalParameter()
 	}
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
@@ -923,6 +973,159 @@ func (msg ChangePostParamMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+//----------------------------------------
+// ChangeInfraParamMsg Msg Implementations
+
+func NewChangeInfraParamMsg(
+	creator string, parameter param.InfraParam, reason string) ChangeInfraParamMsg {
+	return ChangeInfraParamMsg{
+		Creator:   types.AccountKey(creator),
+		Parameter: parameter,
+		Reason:    reason,
+	}
+}
+
+// GetParameter - implement ChangeParamMsg
+func (msg ChangeInfraParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+
+// GetCreator - implement ChangeParamMsg
+func (msg ChangeInfraParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement ChangeParamMsg
+func (msg ChangeInfraParamMsg) GetReason() string { return msg.Reason }
+
+// Route - implement sdk.Msg
+func (msg ChangeInfraParamMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ChangeInfraParamMsg) Type() string { return "ChangeInfraParamMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ChangeInfraParamMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if msg.Parameter.InfraCoinReturnIntervalSec <= 0 ||
+		msg.Parameter.InfraCoinReturnTimes <= 0 {
+		return ErrIllegalParameter()
+	}
+
+	if !msg.Parameter.InfraMinDeposit.IsPositive() {
+		return ErrIllegalParameter()
+	}
+
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg ChangeInfraParamMsg) String() string {
+	return fmt.Sprintf("ChangeInfraParamMsg{Creator:%v, param:%v}", msg.Creator, msg.Parameter)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeInfraParamMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeInfraParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeInfraParamMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeInfraParamMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ChangeFeatureParamMsg Msg Implementations
+
+func NewChangeFeatureParamMsg(
+	creator string, parameter param.FeatureParam, reason string) ChangeFeatureParamMsg {
+	return ChangeFeatureParamMsg{
+		Creator:   types.AccountKey(creator),
+		Parameter: parameter,
+		Reason:    reason,
+	}
+}
+
+// GetParameter - implement ChangeParamMsg
+func (msg ChangeFeatureParamMsg) GetParameter() param.Parameter { return msg.Parameter }
+
+// GetCreator - implement ChangeParamMsg
+func (msg ChangeFeatureParamMsg) GetCreator() types.AccountKey { return msg.Creator }
+
+// GetReason - implement ChangeParamMsg
+func (msg ChangeFeatureParamMsg) GetReason() string { return msg.Reason }
+
+// Route - implement sdk.Msg
+func (msg ChangeFeatureParamMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ChangeFeatureParamMsg) Type() string { return "ChangeFeatureParamMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ChangeFeatureParamMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	names := map[string]bool{}
+	for _, feature := range msg.Parameter.Features {
+		if !param.IsKnownFeature(feature.Name) || names[feature.Name] || feature.Height < 0 {
+			return ErrIllegalParameter()
+		}
+		names[feature.Name] = true
+	}
+
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg ChangeFeatureParamMsg) String() string {
+	return fmt.Sprintf("ChangeFeatureParamMsg{Creator:%v, param:%v}", msg.Creator, msg.Parameter)
+}
+
+// GetPermission - implement types.Msg
+func (msg ChangeFeatureParamMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ChangeFeatureParamMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ChangeFeatureParamMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ChangeFeatureParamMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 //----------------------------------------
 // ChangeBandwidthParamMsg Msg Implementations
 
@@ -966,6 +1169,9 @@ func (msg ChangeBandwidthParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.SecondsToRecoverBandwidth <= 0 {
 		return ErrIllegalParameter()
 	}
+	if msg.Parameter.MinGasPrice.IsNil() || msg.Parameter.MinGasPrice.IsNegative() {
+		return ErrIllegalParameter()
+	}
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
@@ -341,6 +341,144 @@ func TestChangeDeveloperParamMsg(t *testing.T) {
 	}
 }
 
+func TestChangeInfraParamMsg(t *testing.T) {
+	p1 := param.InfraParam{
+		InfraMinDeposit:            types.NewCoinFromInt64(1 * types.Decimals),
+		InfraCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraCoinReturnTimes:       int64(7),
+	}
+
+	p2 := p1
+	p2.InfraCoinReturnTimes = int64(-7)
+
+	p3 := p1
+	p3.InfraCoinReturnIntervalSec = int64(0)
+
+	p4 := p1
+	p4.InfraMinDeposit = types.NewCoinFromInt64(0)
+
+	testCases := []struct {
+		testName            string
+		changeInfraParamMsg ChangeInfraParamMsg
+		expectedError       sdk.Error
+	}{
+		{
+			testName:            "normal case",
+			changeInfraParamMsg: NewChangeInfraParamMsg("user1", p1, ""),
+			expectedError:       nil,
+		},
+		{
+			testName:            "negative InfraCoinReturnTimes is illegal",
+			changeInfraParamMsg: NewChangeInfraParamMsg("user1", p2, ""),
+			expectedError:       ErrIllegalParameter(),
+		},
+		{
+			testName:            "zero InfraCoinReturnIntervalSec is illegal",
+			changeInfraParamMsg: NewChangeInfraParamMsg("user1", p3, ""),
+			expectedError:       ErrIllegalParameter(),
+		},
+		{
+			testName:            "zero InfraMinDeposit is illegal",
+			changeInfraParamMsg: NewChangeInfraParamMsg("user1", p4, ""),
+			expectedError:       ErrIllegalParameter(),
+		},
+		{
+			testName:            "empty username is illegal",
+			changeInfraParamMsg: NewChangeInfraParamMsg("", p1, ""),
+			expectedError:       ErrInvalidUsername(),
+		},
+		{
+			testName: "reason is too long",
+			changeInfraParamMsg: NewChangeInfraParamMsg(
+				"user1", p1, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeInfraParamMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestChangeFeatureParamMsg(t *testing.T) {
+	p1 := param.DefaultFeatureParam()
+
+	p2 := param.FeatureParam{
+		Features: []param.FeatureActivation{{Name: "", Height: 1}},
+	}
+
+	p3 := param.FeatureParam{
+		Features: []param.FeatureActivation{{Name: types.FeatureNoCostDonation, Height: -1}},
+	}
+
+	p4 := param.FeatureParam{
+		Features: []param.FeatureActivation{
+			{Name: types.FeatureNoCostDonation, Height: 1}, {Name: types.FeatureNoCostDonation, Height: 2}},
+	}
+
+	p5 := param.FeatureParam{
+		Features: []param.FeatureActivation{{Name: "unknown-feature", Height: 1}},
+	}
+
+	testCases := []struct {
+		testName              string
+		changeFeatureParamMsg ChangeFeatureParamMsg
+		expectedError         sdk.Error
+	}{
+		{
+			testName:              "normal case",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("user1", p1, ""),
+			expectedError:         nil,
+		},
+		{
+			testName:              "no feature is allowed",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("user1", param.FeatureParam{}, ""),
+			expectedError:         nil,
+		},
+		{
+			testName:              "empty feature name is illegal",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("user1", p2, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
+		{
+			testName:              "unknown feature is illegal",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("user1", p5, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
+		{
+			testName:              "negative activation height is illegal",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("user1", p3, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
+		{
+			testName:              "duplicate feature is illegal",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("user1", p4, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
+		{
+			testName:              "empty username is illegal",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg("", p1, ""),
+			expectedError:         ErrInvalidUsername(),
+		},
+		{
+			testName: "reason is too long",
+			changeFeatureParamMsg: NewChangeFeatureParamMsg(
+				"user1", p1, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.changeFeatureParamMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestChangeValidatorParamMsg(t *testing.T) {
 	p1 := param.ValidatorParam{
 		ValidatorMinWithdraw:           types.NewCoinFromInt64(1 * types.Decimals),
@@ -630,6 +768,7 @@ func TestChangeAccountParamMsg(t *testing.T) {
 		RegisterFee:                  types.NewCoinFromInt64(1 * types.Decimals),
 		FirstDepositFullCoinDayLimit: types.NewCoinFromInt64(1 * types.Decimals),
 		MaxNumFrozenMoney:            10,
+		MaxNumBalanceHistory:         1000,
 	}
 
 	p2 := p1
@@ -647,6 +786,12 @@ func TestChangeAccountParamMsg(t *testing.T) {
 	p6 := p1
 	p6.MaxNumFrozenMoney = -1
 
+	p7 := p1
+	p7.MaxNumBalanceHistory = 0
+
+	p8 := p1
+	p8.MaxNumBalanceHistory = -1
+
 	testCases := []struct {
 		testName              string
 		changeAccountParamMsg ChangeAccountParamMsg
@@ -692,6 +837,16 @@ func TestChangeAccountParamMsg(t *testing.T) {
 			changeAccountParamMsg: NewChangeAccountParamMsg("user1", p6, ""),
 			expectedError:         ErrIllegalParameter(),
 		},
+		{
+			testName:              "zero MaxNumBalanceHistory is valid",
+			changeAccountParamMsg: NewChangeAccountParamMsg("user1", p7, ""),
+			expectedError:         nil,
+		},
+		{
+			testName:              "negative MaxNumBalanceHistory is invalid",
+			changeAccountParamMsg: NewChangeAccountParamMsg("user1", p8, ""),
+			expectedError:         ErrIllegalParameter(),
+		},
 		{
 			testName: "reason is too long",
 			changeAccountParamMsg: NewChangeAccountParamMsg(
@@ -719,6 +874,8 @@ func TestChangeBandwidthParamMsg(t *testing.T) {
 		SecondsToRecoverBandwidth:   int64(7 * 24 * 3600),
 		CapacityUsagePerTransaction: types.NewCoinFromInt64(1 * types.Decimals),
 		VirtualCoin:                 types.NewCoinFromInt64(1 * types.Decimals),
+		FeeEnabled:                  false,
+		MinGasPrice:                 types.NewDecFromRat(1, 10),
 	}
 
 	p2 := p1
@@ -730,6 +887,13 @@ func TestChangeBandwidthParamMsg(t *testing.T) {
 	p4 := p1
 	p4.VirtualCoin = types.NewCoinFromInt64(-1)
 
+	p5 := p1
+	p5.FeeEnabled = true
+	p5.MinGasPrice = types.NewDecFromRat(-1, 10)
+
+	p6 := p1
+	p6.FeeEnabled = true
+
 	testCases := []struct {
 		testName                string
 		changeBandwidthParamMsg ChangeBandwidthParamMsg
@@ -765,6 +929,16 @@ func TestChangeBandwidthParamMsg(t *testing.T) {
 			changeBandwidthParamMsg: NewChangeBandwidthParamMsg("user1", p4, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
+		{
+			testName:                "negative MinGasPrice is illegal",
+			changeBandwidthParamMsg: NewChangeBandwidthParamMsg("user1", p5, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "enable fee",
+			changeBandwidthParamMsg: NewChangeBandwidthParamMsg("user1", p6, ""),
+			expectedError:           nil,
+		},
 		{
 			testName: "reason is too long",
 			changeBandwidthParamMsg: NewChangeBandwidthParamMsg(
@@ -877,6 +1051,12 @@ func TestDeletePostContentMsg(t *testing.T) {
 				"user1", "permlink", tooLongOfUTF8Reason),
 			expectedError: ErrReasonTooLong(),
 		},
+		{
+			testName: "negative revision is illegal",
+			deletePostContentMsg: DeletePostContentMsg{
+				Creator: "user1", Permlink: "permlink", Revision: -1, Reason: "reason"},
+			expectedError: ErrInvalidCensorshipRevision(-1),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -895,34 +1075,55 @@ func TestUpgradeProtocolMsg(t *testing.T) {
 	}{
 		{
 			testName:           "normal case",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "upgrade2", 100, ""),
 			expectedError:      nil,
 		},
 		{
 			testName:           "too short username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("us", "link", "upgrade2", 100, ""),
 			expectedError:      ErrInvalidUsername(),
 		},
 		{
 			testName:           "too long username is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "link", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1user1user1user1user1user1", "link", "upgrade2", 100, ""),
 			expectedError:      ErrInvalidUsername(),
 		},
 		{
 			testName:           "empty link is illegal",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", ""),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", "upgrade2", 100, ""),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", "upgrade2", 100, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
 			expectedError:      ErrInvalidLink(),
 		},
 		{
 			testName:           "utf8 reason is too long",
-			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", tooLongOfUTF8Reason),
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "", "upgrade2", 100, tooLongOfUTF8Reason),
 			expectedError:      ErrInvalidLink(),
 		},
+		{
+			testName:           "empty upgrade name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "", 100, ""),
+			expectedError:      ErrInvalidUpgradeName(),
+		},
+		{
+			testName: "too long upgrade name is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg(
+				"user1", "link", string(make([]byte, types.MaximumLengthOfUpgradeName+1)), 100, ""),
+			expectedError: ErrInvalidUpgradeName(),
+		},
+		{
+			testName:           "zero upgrade height is illegal",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "upgrade2", 0, ""),
+			expectedError:      ErrInvalidUpgradeHeight(0),
+		},
+		{
+			testName:           "reason is too long with valid link",
+			upgradeProtocolMsg: NewUpgradeProtocolMsg("user1", "link", "upgrade2", 100, tooLongOfUTF8Reason),
+			expectedError:      ErrReasonTooLong(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -947,7 +1148,7 @@ func TestMsgPermission(t *testing.T) {
 		},
 		{
 			testName:         "upgrade protocol msg",
-			msg:              NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:              NewUpgradeProtocolMsg("creator", "link", "upgrade2", 100, ""),
 			expectPermission: types.TransactionPermission,
 		},
 		{
@@ -1032,7 +1233,7 @@ func TestGetSignBytes(t *testing.T) {
 		},
 		{
 			testName: "upgrade protocol msg",
-			msg:      NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:      NewUpgradeProtocolMsg("creator", "link", "upgrade2", 100, ""),
 		},
 		{
 			testName: "change global allocaiton param msg",
@@ -1104,7 +1305,7 @@ func TestGetSigners(t *testing.T) {
 		},
 		{
 			testName:      "upgrade protocol msg",
-			msg:           NewUpgradeProtocolMsg("creator", "link", ""),
+			msg:           NewUpgradeProtocolMsg("creator", "link", "upgrade2", 100, ""),
 			expectSigners: []types.AccountKey{"creator"},
 		},
 		{
@@ -4,6 +4,9 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal/model"
+	"github.com/lino-network/lino/x/vote"
+	voteModel "github.com/lino-network/lino/x/vote/model"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -20,18 +23,28 @@ const (
 	QueryNextProposal    = "next"
 	QueryOngoingProposal = "ongoing"
 	QueryExpiredProposal = "expired"
+	QueryUpgradePlan     = "upgradePlan"
+	QueryProposalVotes   = "votes"
+	QueryProposalOutcome = "outcome"
 )
 
 // creates a querier for proposal REST endpoints
-func NewQuerier(pm ProposalManager) sdk.Querier {
+func NewQuerier(pm ProposalManager, vm vote.VoteManager) sdk.Querier {
 	cdc := wire.New()
 	wire.RegisterCrypto(cdc)
+	model.RegisterWire(cdc)
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryOngoingProposal:
 			return queryOngoingProposal(ctx, cdc, path[1:], req, pm)
 		case QueryExpiredProposal:
 			return queryExpiredProposal(ctx, cdc, path[1:], req, pm)
+		case QueryUpgradePlan:
+			return queryUpgradePlan(ctx, cdc, path[1:], req, pm)
+		case QueryProposalVotes:
+			return queryProposalVotes(ctx, cdc, path[1:], req, pm, vm)
+		case QueryProposalOutcome:
+			return queryProposalOutcome(ctx, cdc, path[1:], req, pm, vm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown proposal query endpoint")
 		}
@@ -67,3 +80,57 @@ func queryExpiredProposal(ctx sdk.Context, cdc *wire.Codec, path []string, req a
 	}
 	return res, nil
 }
+
+func queryUpgradePlan(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	plan, err := pm.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(plan)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryProposalVotes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager, vm vote.VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	proposalID := types.ProposalKey(path[0])
+	if !pm.DoesProposalExist(ctx, proposalID) {
+		return nil, model.ErrProposalNotFound()
+	}
+	votes, err := vm.GetAllVotes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if votes == nil {
+		votes = []voteModel.Vote{}
+	}
+	res, marshalErr := cdc.MarshalJSON(votes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryProposalOutcome(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager, vm vote.VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	proposalID := types.ProposalKey(path[0])
+	totalVotingPower, err := vm.GetTotalVotingPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+	outcome, err := pm.GetProposalOutcome(ctx, proposalID, totalVotingPower)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(outcome)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
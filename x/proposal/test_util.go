@@ -108,7 +108,7 @@ func createTestPost(
 	err = pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content,
-		msg.Title, splitRate, msg.Links)
+		msg.Title, splitRate, msg.Links, nil)
 
 	assert.Nil(t, err)
 	return user, postID
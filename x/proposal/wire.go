@@ -2,6 +2,8 @@ package proposal
 
 import (
 	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/lino-network/lino/x/proposal/model"
 )
 
 // Register concrete types on wire codec
@@ -18,6 +20,9 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ChangeBandwidthParamMsg{}, "lino/changeBandwidthParam", nil)
 	cdc.RegisterConcrete(ChangeAccountParamMsg{}, "lino/changeAccountParam", nil)
 	cdc.RegisterConcrete(ChangePostParamMsg{}, "lino/changePostParam", nil)
+	cdc.RegisterConcrete(ChangeInfraParamMsg{}, "lino/changeInfraParam", nil)
+	cdc.RegisterConcrete(ChangeFeatureParamMsg{}, "lino/changeFeatureParam", nil)
+	model.RegisterWire(cdc)
 }
 
 var msgCdc = wire.New()
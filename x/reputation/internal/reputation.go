@@ -20,6 +20,10 @@ type Reputation interface {
 	// ExportImporter
 	ExportToFile(file string)
 	ImportFromFile(file string)
+	Export() *UserReputationTable
+	Import(tb *UserReputationTable)
+	IterateUserReputations(process func(UserReputation))
+	ImportUserReputation(v UserReputation)
 }
 
 type ReputationImpl struct {
@@ -40,10 +44,25 @@ func (rep ReputationImpl) ImportFromFile(f string) {
 	rep.store.ImportFromFile(f)
 }
 
+// Export - implementing ExporteImporter
+func (rep ReputationImpl) Export() *UserReputationTable {
+	return rep.store.Export()
+}
+
 // Import - implementing ExporteImporter
-// func (rep ReputationImpl) Import(dt []byte) error {
-// 	rep.store.Import(dt)
-// }
+func (rep ReputationImpl) Import(tb *UserReputationTable) {
+	rep.store.Import(tb)
+}
+
+// IterateUserReputations - implementing ExporteImporter
+func (rep ReputationImpl) IterateUserReputations(process func(UserReputation)) {
+	rep.store.IterateUserReputations(process)
+}
+
+// ImportUserReputation - implementing ExporteImporter
+func (rep ReputationImpl) ImportUserReputation(v UserReputation) {
+	rep.store.ImportUserReputation(v)
+}
 
 func (rep ReputationImpl) GetReputation(u Uid) Rep {
 	customerScore := rep.GetSettledCustomerScore(u)
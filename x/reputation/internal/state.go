@@ -1,5 +1,8 @@
 package internal
 
+// UserReputationTableName - table name of user reputations, used in streaming export and import.
+const UserReputationTableName = "reputations"
+
 // UserReputation - pk: Username
 type UserReputation struct {
 	Username      Uid `json:"username"`
@@ -35,7 +35,7 @@ type Store interface {
 // This store needs to be merkelized to support fast rollback.
 type ReputationStore interface {
 	// TODO(yumin): these two are all in memory, which is extremely bad if state is large.
-	// should changed to io.Write/Reader.
+	// use IterateUserReputations and ImportUserReputation for large state.
 	// Export all state to deterministic bytes
 	Export() *UserReputationTable
 	ExportToFile(file string)
@@ -44,6 +44,11 @@ type ReputationStore interface {
 	Import(tb *UserReputationTable)
 	ImportFromFile(file string)
 
+	// visit reputation of users one at a time, in deterministic order.
+	IterateUserReputations(process func(UserReputation))
+	// import reputation of a single user.
+	ImportUserReputation(v UserReputation)
+
 	// Note that, this value may not be the exact customer score of the user
 	// due to there might be unsettled keys remaining.
 	// Also, because that user can get free reputation when they lockdown coins, this value
@@ -236,6 +241,13 @@ func NewReputationStore(s Store, n int) ReputationStore {
 
 func (impl reputationStoreImpl) Export() *UserReputationTable {
 	rst := &UserReputationTable{}
+	impl.IterateUserReputations(func(v UserReputation) {
+		rst.Reputations = append(rst.Reputations, v)
+	})
+	return rst
+}
+
+func (impl reputationStoreImpl) IterateUserReputations(process func(UserReputation)) {
 	itr := impl.store.Iterator(repUserMetaPrefix, PrefixEndBytes(repUserMetaPrefix))
 	defer itr.Close()
 	for ; itr.Valid(); itr.Next() {
@@ -244,13 +256,12 @@ func (impl reputationStoreImpl) Export() *UserReputationTable {
 			continue
 		}
 		v := impl.getUserMeta(uid)
-		rst.Reputations = append(rst.Reputations, UserReputation{
-			Username: uid,
+		process(UserReputation{
+			Username:      uid,
 			CustomerScore: v.CustomerScore,
-			FreeScore: v.FreeScore,
+			FreeScore:     v.FreeScore,
 		})
 	}
-	return rst
 }
 
 func (impl reputationStoreImpl) ExportToFile(file string) {
@@ -271,13 +282,17 @@ func (impl reputationStoreImpl) ExportToFile(file string) {
 
 func (impl reputationStoreImpl) Import(tb *UserReputationTable) {
 	for _, v := range tb.Reputations {
-		impl.setUserMeta(v.Username, &userMeta{
-			FreeScore: v.FreeScore,
-			CustomerScore: v.CustomerScore,
-		})
+		impl.ImportUserReputation(v)
 	}
 }
 
+func (impl reputationStoreImpl) ImportUserReputation(v UserReputation) {
+	impl.setUserMeta(v.Username, &userMeta{
+		FreeScore:     v.FreeScore,
+		CustomerScore: v.CustomerScore,
+	})
+}
+
 func (impl reputationStoreImpl) ImportFromFile(file string) {
 	f, err := os.Open(file)
 	if err != nil {
@@ -11,6 +11,9 @@ import (
 	model "github.com/lino-network/lino/x/reputation/internal"
 )
 
+// UserReputationTable - reputation state used in upgrade export and import.
+type UserReputationTable = model.UserReputationTable
+
 // ReputationManager - adaptor for reputation math model and cosmos application.
 type ReputationManager struct {
 	storeKey    sdk.StoreKey
@@ -209,3 +212,49 @@ func (rep ReputationManager) ImportFromFile(ctx sdk.Context, file string) error
 	handler.ImportFromFile(file)
 	return nil
 }
+
+// Export state of reputation system.
+func (rep ReputationManager) Export(ctx sdk.Context) (*UserReputationTable, sdk.Error) {
+	handler, err := rep.getHandler(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler.Export(), nil
+}
+
+// Import state of reputation system.
+func (rep ReputationManager) Import(ctx sdk.Context, tb *UserReputationTable) sdk.Error {
+	handler, err := rep.getHandler(ctx)
+	if err != nil {
+		return err
+	}
+	handler.Import(tb)
+	return nil
+}
+
+// ExportRows - stream state of reputation system through @p write, one user at a time.
+func (rep ReputationManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	handler, err := rep.getHandler(ctx)
+	if err != nil {
+		panic(err)
+	}
+	handler.IterateUserReputations(func(v model.UserReputation) {
+		write(model.UserReputationTableName, v)
+	})
+}
+
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (rep ReputationManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	handler, err := rep.getHandler(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if table != model.UserReputationTableName {
+		panic("[rep] Failed to import: unknown table " + table)
+	}
+	row := model.UserReputation{}
+	if err := read(&row); err != nil {
+		panic("[rep] Failed to import: " + err.Error())
+	}
+	handler.ImportUserReputation(row)
+}
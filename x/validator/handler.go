@@ -77,7 +77,7 @@ func handleDepositMsg(
 	if err := valManager.TryBecomeOncallValidator(ctx, msg.Username); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Username, "", coin, types.ValidatorDeposit)}
 }
 
 // Handle Withdraw Msg
@@ -107,7 +107,7 @@ func handleWithdrawMsg(
 		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags("", msg.Username, coin, types.ValidatorReturnCoin)}
 }
 
 func handleRevokeMsg(
@@ -132,7 +132,7 @@ func handleRevokeMsg(
 		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags("", msg.Username, coin, types.ValidatorReturnCoin)}
 }
 
 func returnCoinTo(
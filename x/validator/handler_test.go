@@ -30,7 +30,8 @@ func TestRegisterBasic(t *testing.T) {
 	valKey := secp256k1.GenPrivKey().PubKey()
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 
 	// check acc1's money has been withdrawn
 	acc1Balance, _ := am.GetSavingFromBank(ctx, user1)
@@ -96,7 +97,8 @@ func TestRevokeBasic(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 
 	// now user1 should be the only validator
 	verifyList, _ := valManager.storage.GetValidatorList(ctx)
@@ -106,7 +108,8 @@ func TestRevokeBasic(t *testing.T) {
 	// let user1 revoke candidancy
 	msg2 := NewValidatorRevokeMsg("user1")
 	result2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", user1, valParam.ValidatorMinCommittingDeposit, types.ValidatorReturnCoin)}, result2)
 
 	verifyList2, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 0, len(verifyList2.OncallValidators))
@@ -150,7 +153,8 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 		valKeys[i] = secp256k1.GenPrivKey().PubKey()
 		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	lst, _ := valManager.storage.GetValidatorList(ctx)
@@ -165,7 +169,8 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 	result := handler(ctx, msg)
 
 	lst2, _ := valManager.storage.GetValidatorList(ctx)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		users[3], "", types.NewCoinFromInt64(15*types.Decimals), types.ValidatorDeposit)}, result)
 	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(50*types.Decimals)), lst2.LowestPower)
 	assert.Equal(t, users[4], lst2.LowestValidator)
 
@@ -177,11 +182,13 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 
 	withdrawMsg2 := NewValidatorWithdrawMsg("user2", coinToString(valParam.ValidatorMinWithdraw))
 	resultWithdraw2 := handler(ctx, withdrawMsg2)
-	assert.Equal(t, sdk.Result{}, resultWithdraw2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", users[1], valParam.ValidatorMinWithdraw, types.ValidatorReturnCoin)}, resultWithdraw2)
 	//revoke a non oncall valodator wont change anything related to oncall list
 	revokeMsg := NewValidatorRevokeMsg("user2")
 	result2 := handler(ctx, revokeMsg)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", users[1], valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(20*types.Decimals)).Minus(valParam.ValidatorMinWithdraw), types.ValidatorReturnCoin)}, result2)
 
 	lst3, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(50*types.Decimals)), lst3.LowestPower)
@@ -194,7 +201,8 @@ func TestRevokeOncallValidatorAndSubstitutionExists(t *testing.T) {
 	// list become the lowest validator
 	revokeMsg2 := NewValidatorRevokeMsg("user6")
 	result3 := handler(ctx, revokeMsg2)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", users[5], valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(60*types.Decimals)), types.ValidatorReturnCoin)}, result3)
 
 	lst4, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(30*types.Decimals)), lst4.LowestPower)
@@ -219,7 +227,8 @@ func TestRevokeAndDepositAgain(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"user1", "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 
 	lst, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 1, len(lst.AllValidators))
@@ -228,7 +237,8 @@ func TestRevokeAndDepositAgain(t *testing.T) {
 	// let user1 revoke candidancy
 	msg2 := NewValidatorRevokeMsg("user1")
 	result2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", "user1", valParam.ValidatorMinCommittingDeposit, types.ValidatorReturnCoin)}, result2)
 
 	lstEmpty, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 0, len(lstEmpty.AllValidators))
@@ -239,7 +249,8 @@ func TestRevokeAndDepositAgain(t *testing.T) {
 	result3 := handler(ctx, msg3)
 
 	lst2, _ := valManager.storage.GetValidatorList(ctx)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"user1", "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result3)
 	assert.Equal(t, 1, len(lst2.AllValidators))
 	assert.Equal(t, 1, len(lst2.OncallValidators))
 
@@ -262,7 +273,8 @@ func TestWithdrawBasic(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 
 	// now user1 should be the only validator
 	verifyList, _ := valManager.storage.GetValidatorList(ctx)
@@ -294,7 +306,8 @@ func TestDepositBasic(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("user1", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 
 	// check acc1's money has been withdrawn
 	acc1Balance, _ := am.GetSavingFromBank(ctx, user1)
@@ -368,7 +381,8 @@ func TestValidatorReplacement(t *testing.T) {
 		valKeys[i] = secp256k1.GenPrivKey().PubKey()
 		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	// check validator list, the lowest power is 10
@@ -388,11 +402,13 @@ func TestValidatorReplacement(t *testing.T) {
 	deposit := coinToString(valParam.ValidatorMinCommittingDeposit)
 	msg := NewValidatorDepositMsg("noPowerUser", deposit, valKey, "")
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"noPowerUser", "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 
 	//check the user hasn't been added to oncall validators but in the pool
 	verifyList2, _ := valManager.storage.GetValidatorList(ctx)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"noPowerUser", "", valParam.ValidatorMinCommittingDeposit, types.ValidatorDeposit)}, result)
 	assert.Equal(t, true,
 		verifyList2.LowestPower.IsEqual(valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(10*types.Decimals))))
 	assert.Equal(t, users[0], verifyList2.LowestValidator)
@@ -409,7 +425,8 @@ func TestValidatorReplacement(t *testing.T) {
 	deposit = coinToString(valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(88 * types.Decimals)))
 	msg = NewValidatorDepositMsg("powerfulUser", deposit, valKey, "")
 	result = handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"powerfulUser", "", valParam.ValidatorMinCommittingDeposit.Plus(types.NewCoinFromInt64(88*types.Decimals)), types.ValidatorDeposit)}, result)
 
 	verifyList3, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, true,
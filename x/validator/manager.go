@@ -6,6 +6,7 @@ import (
 
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/validator/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -247,7 +248,7 @@ func (vm ValidatorManager) PunishOncallValidator(
 
 // FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine
 func (vm ValidatorManager) FireIncompetentValidator(
-	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, sdk.Error) {
+	ctx sdk.Context, byzantineValidators []abci.Evidence, gm *global.GlobalManager) (types.Coin, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
@@ -272,6 +273,9 @@ func (vm ValidatorManager) FireIncompetentValidator(
 				if err != nil {
 					return totalPenalty, err
 				}
+				if err := gm.AddBalanceChange(ctx, validator.Username, actualPenalty, types.ValidatorPenalty); err != nil {
+					return totalPenalty, err
+				}
 				totalPenalty = totalPenalty.Plus(actualPenalty)
 				break
 			}
@@ -283,6 +287,9 @@ func (vm ValidatorManager) FireIncompetentValidator(
 			if err != nil {
 				return totalPenalty, err
 			}
+			if err := gm.AddBalanceChange(ctx, validator.Username, actualPenalty, types.ValidatorPenalty); err != nil {
+				return totalPenalty, err
+			}
 
 			totalPenalty = totalPenalty.Plus(actualPenalty)
 		}
@@ -293,7 +300,7 @@ func (vm ValidatorManager) FireIncompetentValidator(
 
 // PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal
 func (vm ValidatorManager) PunishValidatorsDidntVote(
-	ctx sdk.Context, penaltyList []types.AccountKey) (types.Coin, sdk.Error) {
+	ctx sdk.Context, penaltyList []types.AccountKey, gm *global.GlobalManager) (types.Coin, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
@@ -305,6 +312,9 @@ func (vm ValidatorManager) PunishValidatorsDidntVote(
 		if err != nil {
 			return totalPenalty, err
 		}
+		if err := gm.AddBalanceChange(ctx, validator, actualPenalty, types.ValidatorPenalty); err != nil {
+			return totalPenalty, err
+		}
 		totalPenalty = totalPenalty.Plus(actualPenalty)
 	}
 
@@ -585,3 +595,13 @@ func (vm ValidatorManager) Export(ctx sdk.Context) *model.ValidatorTables {
 func (vm ValidatorManager) Import(ctx sdk.Context, tb *model.ValidatorTablesIR) {
 	vm.storage.Import(ctx, tb)
 }
+
+// ExportRows - stream storage state row by row.
+func (vm ValidatorManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	vm.storage.ExportRows(ctx, write)
+}
+
+// ImportRow - import storage state row by row.
+func (vm ValidatorManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	vm.storage.ImportRow(ctx, table, read)
+}
@@ -38,7 +38,8 @@ func TestByzantines(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	// byzantine
@@ -49,7 +50,7 @@ func TestByzantines(t *testing.T) {
 			Address: valKeys[idx].Address(),
 			Power:   1000}})
 	}
-	_, err := valManager.FireIncompetentValidator(ctx, byzantines)
+	_, err := valManager.FireIncompetentValidator(ctx, byzantines, &gm)
 	assert.Nil(t, err)
 
 	validatorList3, _ := valManager.storage.GetValidatorList(ctx)
@@ -85,7 +86,8 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	// construct signing list
@@ -146,7 +148,7 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{}, &gm)
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
@@ -181,7 +183,8 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	// construct signing list
@@ -242,7 +245,7 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{}, &gm)
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
@@ -285,7 +288,8 @@ func TestGetOncallList(t *testing.T) {
 		name := "user" + strconv.Itoa(i)
 		msg := NewValidatorDepositMsg(name, deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	lst, _ := valManager.GetValidatorList(ctx)
@@ -360,7 +364,8 @@ func TestPunishmentAndSubstitutionExists(t *testing.T) {
 		valKeys[i] = secp256k1.GenPrivKey().PubKey()
 		msg := NewValidatorDepositMsg("user"+strconv.Itoa(i+1), deposit, valKeys[i], "")
 		result := handler(ctx, msg)
-		assert.Equal(t, sdk.Result{}, result)
+		assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+			users[i], "", types.NewCoinFromInt64(num*types.Decimals), types.ValidatorDeposit)}, result)
 	}
 
 	// lowest is user4 with power (min + 400)
@@ -4,6 +4,12 @@ import (
 	"github.com/lino-network/lino/types"
 )
 
+// table names of validator storage, used in streaming export and import.
+const (
+	ValidatorTable     = "validators"
+	ValidatorListTable = "validator_list"
+)
+
 // ValidatorRow - pk: (Username)
 type ValidatorRow struct {
 	Username types.AccountKey `json:"username"`
@@ -100,34 +100,47 @@ func (vs ValidatorStorage) SetValidatorList(ctx sdk.Context, lst *ValidatorList)
 // Export state of validators.
 func (vs ValidatorStorage) Export(ctx sdk.Context) *ValidatorTables {
 	tables := &ValidatorTables{}
+	vs.iterateValidatorRows(ctx, func(row ValidatorRow) {
+		tables.Validators = append(tables.Validators, row)
+	})
+	tables.ValidatorList = vs.validatorListRow(ctx)
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (vs ValidatorStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	vs.iterateValidatorRows(ctx, func(row ValidatorRow) {
+		write(ValidatorTable, row.ToIR())
+	})
+	write(ValidatorListTable, vs.validatorListRow(ctx))
+}
+
+func (vs ValidatorStorage) iterateValidatorRows(ctx sdk.Context, process func(ValidatorRow)) {
 	store := ctx.KVStore(vs.key)
-	// export table.validators
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, validatorSubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			username := types.AccountKey(k[1:])
-			val, err := vs.GetValidator(ctx, username)
-			if err != nil {
-				panic("failed to read validator: " + err.Error())
-			}
-			row := ValidatorRow{
-				Username:  username,
-				Validator: *val,
-			}
-			tables.Validators = append(tables.Validators, row)
+	itr := sdk.KVStorePrefixIterator(store, validatorSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		username := types.AccountKey(k[1:])
+		val, err := vs.GetValidator(ctx, username)
+		if err != nil {
+			panic("failed to read validator: " + err.Error())
 		}
-	}()
-	// export table.validatorList
+		process(ValidatorRow{
+			Username:  username,
+			Validator: *val,
+		})
+	}
+}
+
+func (vs ValidatorStorage) validatorListRow(ctx sdk.Context) ValidatorListRow {
 	list, err := vs.GetValidatorList(ctx)
 	if err != nil {
 		panic("failed to get validator list: " + err.Error())
 	}
-	tables.ValidatorList = ValidatorListRow{
+	return ValidatorListRow{
 		List: *list,
 	}
-	return tables
 }
 
 // Import from tablesIR.
@@ -139,31 +152,61 @@ func (vs ValidatorStorage) Import(ctx sdk.Context, tb *ValidatorTablesIR) {
 	}
 	// import table.Validators
 	for _, v := range tb.Validators {
-		pubkey, err := tmtypes.PB2TM.PubKey(abci.PubKey{
-			Type: v.Validator.ABCIValidator.PubKey.Type,
-			Data: v.Validator.ABCIValidator.PubKey.Data,
-		})
-		check(err)
-		err = vs.SetValidator(ctx, v.Username, &Validator{
-			ABCIValidator: abci.Validator{
-				Address: v.Validator.ABCIValidator.Address,
-				Power:   v.Validator.ABCIValidator.Power,
-			},
-			PubKey:          pubkey,
-			Username:        v.Validator.Username,
-			Deposit:         v.Validator.Deposit,
-			AbsentCommit:    v.Validator.AbsentCommit,
-			ByzantineCommit: v.Validator.ByzantineCommit,
-			ProducedBlocks:  v.Validator.ProducedBlocks,
-			Link:            v.Validator.Link,
-		})
-		check(err)
+		vs.importValidatorRow(ctx, v)
 	}
 	// import ValidatorList
 	err := vs.SetValidatorList(ctx, &tb.ValidatorList.List)
 	check(err)
 }
 
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (vs ValidatorStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[vs] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case ValidatorTable:
+		row := ValidatorRowIR{}
+		check(read(&row))
+		vs.importValidatorRow(ctx, row)
+	case ValidatorListTable:
+		row := ValidatorListRow{}
+		check(read(&row))
+		check(vs.SetValidatorList(ctx, &row.List))
+	default:
+		panic("[vs] Failed to import: unknown table " + table)
+	}
+}
+
+func (vs ValidatorStorage) importValidatorRow(ctx sdk.Context, v ValidatorRowIR) {
+	check := func(e error) {
+		if e != nil {
+			panic("[vs] Failed to import: " + e.Error())
+		}
+	}
+	pubkey, err := tmtypes.PB2TM.PubKey(abci.PubKey{
+		Type: v.Validator.ABCIValidator.PubKey.Type,
+		Data: v.Validator.ABCIValidator.PubKey.Data,
+	})
+	check(err)
+	err = vs.SetValidator(ctx, v.Username, &Validator{
+		ABCIValidator: abci.Validator{
+			Address: v.Validator.ABCIValidator.Address,
+			Power:   v.Validator.ABCIValidator.Power,
+		},
+		PubKey:          pubkey,
+		Username:        v.Validator.Username,
+		Deposit:         v.Validator.Deposit,
+		AbsentCommit:    v.Validator.AbsentCommit,
+		ByzantineCommit: v.Validator.ByzantineCommit,
+		ProducedBlocks:  v.Validator.ProducedBlocks,
+		Link:            v.Validator.Link,
+	})
+	check(err)
+}
+
 func GetValidatorKey(accKey types.AccountKey) []byte {
 	return append(validatorSubstore, accKey...)
 }
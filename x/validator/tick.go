@@ -3,12 +3,14 @@ package validator
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // BeginBlocker - execute before every block, update signing info and record validator set
 func BeginBlocker(
-	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager) (panelty types.Coin) {
+	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager,
+	gm *global.GlobalManager) (panelty types.Coin) {
 	// update preblock validators
 	validatorList, err := vm.GetValidatorList(ctx)
 	if err != nil {
@@ -25,6 +27,6 @@ func BeginBlocker(
 		panic(updateErr)
 	}
 
-	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
+	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators, gm)
 	return
 }
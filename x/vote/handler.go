@@ -64,7 +64,7 @@ func handleStakeInMsg(
 		return err.Result()
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Username, "", coin, types.VoterDeposit)}
 }
 
 func handleStakeOutMsg(
@@ -93,7 +93,7 @@ func handleStakeOutMsg(
 		param.VoterCoinReturnIntervalSec, coin, types.VoteReturnCoin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags("", msg.Username, coin, types.VoteReturnCoin)}
 }
 
 func handleDelegateMsg(
@@ -131,7 +131,7 @@ func handleDelegateMsg(
 	if addErr := vm.AddDelegation(ctx, msg.Voter, msg.Delegator, coin); addErr != nil {
 		return addErr.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Delegator, msg.Voter, coin, types.Delegate)}
 }
 
 func handleDelegatorWithdrawMsg(
@@ -161,7 +161,7 @@ func handleDelegatorWithdrawMsg(
 		param.DelegatorCoinReturnIntervalSec, coin, types.DelegationReturnCoin); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags(msg.Voter, msg.Delegator, coin, types.DelegationReturnCoin)}
 }
 
 func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm *global.GlobalManager, am acc.AccountManager, msg ClaimInterestMsg) sdk.Result {
@@ -177,7 +177,7 @@ func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm *global.GlobalMa
 		ctx, msg.Username, interest, "", "", types.ClaimInterest); err != nil {
 		return err.Result()
 	}
-	return sdk.Result{}
+	return sdk.Result{Tags: types.NewTransferTags("", msg.Username, interest, types.ClaimInterest)}
 }
 
 func AddStake(
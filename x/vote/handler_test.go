@@ -22,7 +22,8 @@ func TestVoterDepositBasic(t *testing.T) {
 	// let user1 register as voter
 	msg := NewStakeInMsg("user1", coinToString(voteParam.MinStakeIn))
 	result := handler(ctx, msg)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, "", voteParam.MinStakeIn, types.VoterDeposit)}, result)
 
 	// check acc1's money has been withdrawn
 	acc1saving, _ := am.GetSavingFromBank(ctx, user1)
@@ -61,7 +62,8 @@ func TestDelegateBasic(t *testing.T) {
 	msg2 := NewDelegateMsg("user2", "user1", coinToString(delegatedCoin))
 	handler(ctx, msg2)
 	result2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user2, user1, delegatedCoin, types.Delegate)}, result2)
 
 	// make sure the voter's voting power is correct
 	voter, _ := vm.storage.GetVoter(ctx, user1)
@@ -77,7 +79,8 @@ func TestDelegateBasic(t *testing.T) {
 	// let user3 delegate power to user1
 	msg3 := NewDelegateMsg("user3", "user1", coinToString(delegatedCoin))
 	result3 := handler(ctx, msg3)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user3, user1, delegatedCoin, types.Delegate)}, result3)
 
 	// check delegator list is correct
 	delegators, _ := vm.storage.GetAllDelegators(ctx, "user1")
@@ -154,7 +157,8 @@ func TestRevokeBasic(t *testing.T) {
 	// let user3 reovke delegation
 	msg4 := NewDelegatorWithdrawMsg("user3", "user1", coinToString(delegatedCoin))
 	result := handler(ctx, msg4)
-	assert.Equal(t, sdk.Result{}, result)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		user1, user3, delegatedCoin, types.DelegationReturnCoin)}, result)
 
 	// make sure user3 won't get coins immediately, but user1 power down immediately
 	voter, _ := vm.storage.GetVoter(ctx, "user1")
@@ -173,7 +177,8 @@ func TestRevokeBasic(t *testing.T) {
 
 	vm.storage.SetReferenceList(ctx, referenceList)
 	result2 := handler(ctx, msg5)
-	assert.Equal(t, sdk.Result{}, result2)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", user1, voteParam.MinStakeIn, types.VoteReturnCoin)}, result2)
 
 	// make sure user2 wont get coins immediately, and delegatin was deleted
 	acc1Balance, _ := am.GetSavingFromBank(ctx, user1)
@@ -228,7 +233,8 @@ func TestVoterWithdraw(t *testing.T) {
 
 	msg3 := NewStakeOutMsg("user1", coinToString(withdraw))
 	result3 := handler(ctx, msg3)
-	assert.Equal(t, sdk.Result{}, result3)
+	assert.Equal(t, sdk.Result{Tags: types.NewTransferTags(
+		"", "user1", withdraw, types.VoteReturnCoin)}, result3)
 
 	linoStat, _ = gs.GetLinoStakeStat(ctx, day)
 
@@ -284,7 +290,7 @@ func TestDelegatorWithdraw(t *testing.T) {
 			delegator:      user2,
 			voter:          user1,
 			withdraw:       delegatedCoin.Minus(delta),
-			expectedResult: sdk.Result{},
+			expectedResult: sdk.Result{Tags: types.NewTransferTags(user1, user2, delegatedCoin.Minus(delta), types.DelegationReturnCoin)},
 		},
 	}
 
@@ -292,7 +298,9 @@ func TestDelegatorWithdraw(t *testing.T) {
 		if tc.addDelegation {
 			msg := NewDelegateMsg(string(tc.delegator), string(tc.voter), coinToString(tc.delegatedCoin))
 			res := handler(ctx, msg)
-			if !assert.Equal(t, sdk.Result{}, res) {
+			wantRes := sdk.Result{
+				Tags: types.NewTransferTags(tc.delegator, tc.voter, tc.delegatedCoin, types.Delegate)}
+			if !assert.Equal(t, wantRes, res) {
 				t.Errorf("failed to add delegation")
 			}
 		}
@@ -383,7 +391,7 @@ func TestDeleteVoteBasic(t *testing.T) {
 	handler(ctx, depositMsg)
 
 	// add vote
-	_ = vm.AddVote(ctx, proposalID1, user2, true)
+	_, _ = vm.AddVote(ctx, proposalID1, user2, true)
 
 	voteList, _ := vm.storage.GetAllVotes(ctx, proposalID1)
 	assert.Equal(t, user2, voteList[0].Voter)
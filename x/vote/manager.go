@@ -101,16 +101,62 @@ func (vm VoteManager) CanBecomeValidator(ctx sdk.Context, username types.Account
 	return voter.LinoStake.IsGTE(param.ValidatorMinVotingDeposit)
 }
 
-// AddVote - voter vote for a proposal
-func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) sdk.Error {
+// AddVote - voter vote for a proposal, power the voter delegated to others is
+// counted to this vote instead of the votes of voters it delegated to.
+// Power deducted from votes already cast is returned to update the proposal tally.
+func (vm VoteManager) AddVote(
+	ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) ([]model.VoteOverride, sdk.Error) {
 	// check if the vote exist
 	if vm.DoesVoteExist(ctx, proposalID, voter) {
-		return ErrVoteAlreadyExist()
+		return nil, ErrVoteAlreadyExist()
 	}
 
-	votingPower, err := vm.GetVotingPower(ctx, voter)
+	votingPower, err := vm.getProposalVotingPower(ctx, proposalID, voter)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// override delegated power of all voters this voter delegated to
+	delegatees, err := vm.storage.GetAllDelegatees(ctx, voter)
+	if err != nil {
+		return nil, err
+	}
+	overridden := []model.VoteOverride{}
+	for _, delegatee := range delegatees {
+		delegation, err := vm.storage.GetDelegation(ctx, delegatee, voter)
+		if err != nil {
+			return nil, err
+		}
+		override := model.VoteOverride{
+			Voter:     delegatee,
+			Delegator: voter,
+			Amount:    delegation.Amount,
+		}
+		if vm.DoesVoteExist(ctx, proposalID, delegatee) {
+			delegateeVote, err := vm.storage.GetVote(ctx, proposalID, delegatee)
+			if err != nil {
+				return nil, err
+			}
+			// delegation may be added after the delegatee voted, only deduct
+			// what the delegatee's vote holds, the voter still gets the full delegation.
+			deducted := model.VoteOverride{
+				Voter:     delegatee,
+				Delegator: voter,
+				Amount:    override.Amount,
+			}
+			if deducted.Amount.IsGT(delegateeVote.VotingPower) {
+				deducted.Amount = delegateeVote.VotingPower
+			}
+			delegateeVote.VotingPower = delegateeVote.VotingPower.Minus(deducted.Amount)
+			if err := vm.storage.SetVote(ctx, proposalID, delegatee, delegateeVote); err != nil {
+				return nil, err
+			}
+			overridden = append(overridden, deducted)
+		}
+		if err := vm.storage.SetVoteOverride(ctx, proposalID, &override); err != nil {
+			return nil, err
+		}
+		votingPower = votingPower.Plus(override.Amount)
 	}
 
 	vote := model.Vote{
@@ -120,9 +166,9 @@ func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 	}
 
 	if err := vm.storage.SetVote(ctx, proposalID, voter, &vote); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return overridden, nil
 }
 
 // GetVote - get vote detail based on voter and proposal ID
@@ -130,6 +176,52 @@ func (vm VoteManager) GetVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 	return vm.storage.GetVote(ctx, proposalID, voter)
 }
 
+// GetAllVotes - get all votes of a proposal
+func (vm VoteManager) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]model.Vote, sdk.Error) {
+	return vm.storage.GetAllVotes(ctx, proposalID)
+}
+
+// DeleteVoteOverrides - delete vote overrides of a decided proposal, unlike votes
+// they only adjust voting power while the proposal is ongoing.
+func (vm VoteManager) DeleteVoteOverrides(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	return vm.storage.DeleteVoteOverrides(ctx, proposalID)
+}
+
+// GetTotalVotingPower - get total voting power of all voters, delegation only moves
+// voting power between voters so it is the total lino stake kept by storage.
+func (vm VoteManager) GetTotalVotingPower(ctx sdk.Context) (types.Coin, sdk.Error) {
+	return vm.storage.GetTotalLinoStake(ctx)
+}
+
+// getProposalVotingPower - get voter voting power on a proposal, excluding
+// power of delegators who already voted on the proposal by themselves.
+func (vm VoteManager) getProposalVotingPower(
+	ctx sdk.Context, proposalID types.ProposalKey, voterName types.AccountKey) (types.Coin, sdk.Error) {
+	votingPower, err := vm.GetVotingPower(ctx, voterName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	overrides, err := vm.storage.GetVoteOverrides(ctx, proposalID, voterName)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	for _, override := range overrides {
+		// delegation may be withdrawn after the delegator voted
+		amount := types.NewCoinFromInt64(0)
+		if delegation, err := vm.storage.GetDelegation(ctx, voterName, override.Delegator); err == nil {
+			amount = delegation.Amount
+		}
+		if amount.IsGT(override.Amount) {
+			amount = override.Amount
+		}
+		if amount.IsGT(votingPower) {
+			amount = votingPower
+		}
+		votingPower = votingPower.Minus(amount)
+	}
+	return votingPower, nil
+}
+
 // AddDelegation - add delegation
 func (vm VoteManager) AddDelegation(ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey, coin types.Coin) sdk.Error {
 	var delegation *model.Delegation
@@ -397,3 +489,13 @@ func (vm VoteManager) Export(ctx sdk.Context) *model.VoterTables {
 func (vm VoteManager) Import(ctx sdk.Context, voter *model.VoterTablesIR) {
 	vm.storage.Import(ctx, voter)
 }
+
+// ExportRows - stream storage state row by row.
+func (vm VoteManager) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	vm.storage.ExportRows(ctx, write)
+}
+
+// ImportRow - import storage state row by row.
+func (vm VoteManager) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	vm.storage.ImportRow(ctx, table, read)
+}
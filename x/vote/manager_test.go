@@ -298,3 +298,123 @@ func TestIsLegalDelegatorWithdraw(t *testing.T) {
 		}
 	}
 }
+
+func TestAddVote(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	proposalID := types.ProposalKey("1")
+
+	vm.AddVoter(ctx, user1, types.NewCoinFromInt64(100))
+	vm.AddVoter(ctx, user2, types.NewCoinFromInt64(200))
+	vm.AddDelegation(ctx, user1, user2, types.NewCoinFromInt64(50))
+
+	testCases := []struct {
+		testName        string
+		voter           types.AccountKey
+		delegation      types.Coin
+		wantOverridden  []model.VoteOverride
+		wantVotingPower map[types.AccountKey]types.Coin
+	}{
+		{
+			testName:       "delegatee voted first",
+			voter:          user1,
+			delegation:     types.NewCoinFromInt64(0),
+			wantOverridden: []model.VoteOverride{},
+			wantVotingPower: map[types.AccountKey]types.Coin{
+				user1: types.NewCoinFromInt64(150),
+			},
+		},
+		{
+			testName:   "delegatee vote power below delegation",
+			voter:      user2,
+			delegation: types.NewCoinFromInt64(150),
+			wantOverridden: []model.VoteOverride{
+				{Voter: user1, Delegator: user2, Amount: types.NewCoinFromInt64(150)},
+			},
+			wantVotingPower: map[types.AccountKey]types.Coin{
+				user1: types.NewCoinFromInt64(0),
+				user2: types.NewCoinFromInt64(200),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		// delegation added after the delegatee voted isn't counted in its vote
+		if !tc.delegation.IsZero() {
+			if err := vm.AddDelegation(ctx, user1, user2, tc.delegation); err != nil {
+				t.Errorf("%s: failed to add delegation, got err %v", tc.testName, err)
+			}
+		}
+		overridden, err := vm.AddVote(ctx, proposalID, tc.voter, true)
+		if err != nil {
+			t.Errorf("%s: failed to add vote, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantOverridden, overridden) {
+			t.Errorf("%s: diff overridden votes, got %v, want %v", tc.testName, overridden, tc.wantOverridden)
+		}
+		for voter, wantPower := range tc.wantVotingPower {
+			vote, err := vm.GetVote(ctx, proposalID, voter)
+			if err != nil {
+				t.Errorf("%s: failed to get vote of %s, got err %v", tc.testName, voter, err)
+				continue
+			}
+			if !vote.VotingPower.IsEqual(wantPower) {
+				t.Errorf("%s: diff voting power of %s, got %v, want %v", tc.testName, voter, vote.VotingPower, wantPower)
+			}
+		}
+	}
+}
+
+func TestGetTotalVotingPower(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+
+	testCases := []struct {
+		testName  string
+		change    func() sdk.Error
+		wantTotal types.Coin
+	}{
+		{
+			testName:  "first voter",
+			change:    func() sdk.Error { return vm.AddVoter(ctx, user1, types.NewCoinFromInt64(100)) },
+			wantTotal: types.NewCoinFromInt64(100),
+		},
+		{
+			testName:  "second voter",
+			change:    func() sdk.Error { return vm.AddVoter(ctx, user2, types.NewCoinFromInt64(200)) },
+			wantTotal: types.NewCoinFromInt64(300),
+		},
+		{
+			testName:  "delegation doesn't change total",
+			change:    func() sdk.Error { return vm.AddDelegation(ctx, user1, user2, types.NewCoinFromInt64(50)) },
+			wantTotal: types.NewCoinFromInt64(300),
+		},
+		{
+			testName:  "add lino stake",
+			change:    func() sdk.Error { return vm.AddLinoStake(ctx, user1, types.NewCoinFromInt64(20)) },
+			wantTotal: types.NewCoinFromInt64(320),
+		},
+		{
+			testName:  "minus lino stake",
+			change:    func() sdk.Error { return vm.MinusLinoStake(ctx, user2, types.NewCoinFromInt64(70)) },
+			wantTotal: types.NewCoinFromInt64(250),
+		},
+	}
+
+	for _, tc := range testCases {
+		if err := tc.change(); err != nil {
+			t.Errorf("%s: failed to change voter, got err %v", tc.testName, err)
+		}
+		total, err := vm.GetTotalVotingPower(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get total voting power, got err %v", tc.testName, err)
+		}
+		if !total.IsEqual(tc.wantTotal) {
+			t.Errorf("%s: diff total voting power, got %v, want %v", tc.testName, total, tc.wantTotal)
+		}
+	}
+}
@@ -66,3 +66,23 @@ func ErrFailedToUnmarshalDelegation(err error) sdk.Error {
 func ErrFailedToUnmarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReferenceList, fmt.Sprintf("failed to unmarshal reference list: %s", err.Error()))
 }
+
+// ErrFailedToMarshalVoteOverride - error if marshal vote override failed
+func ErrFailedToMarshalVoteOverride(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalVoteOverride, fmt.Sprintf("failed to marshal vote override: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalVoteOverride - error if unmarshal vote override failed
+func ErrFailedToUnmarshalVoteOverride(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalVoteOverride, fmt.Sprintf("failed to unmarshal vote override: %s", err.Error()))
+}
+
+// ErrFailedToMarshalTotalStake - error if marshal total lino stake failed
+func ErrFailedToMarshalTotalStake(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalTotalStake, fmt.Sprintf("failed to marshal total lino stake: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalTotalStake - error if unmarshal total lino stake failed
+func ErrFailedToUnmarshalTotalStake(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalTotalStake, fmt.Sprintf("failed to unmarshal total lino stake: %s", err.Error()))
+}
@@ -4,6 +4,15 @@ import (
 	types "github.com/lino-network/lino/types"
 )
 
+// table names of vote storage, used in streaming export and import.
+const (
+	VoterTable             = "voters"
+	DelegationTable        = "delegations"
+	VoteTable              = "votes"
+	VoteOverrideTable      = "vote_overrides"
+	ReferenceListTableName = "reference_list"
+)
+
 // VoterRow - pk: username
 type VoterRow struct {
 	Username types.AccountKey `json:"username"`
@@ -17,6 +26,19 @@ type DelegationRow struct {
 	Delegation Delegation       `json:"delegation"`
 }
 
+// VoteRow - pk: (proposal_id, voter)
+type VoteRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Voter      types.AccountKey  `json:"voter"`
+	Vote       Vote              `json:"vote"`
+}
+
+// VoteOverrideRow - pk: (proposal_id, voter, delegator)
+type VoteOverrideRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Override   VoteOverride      `json:"override"`
+}
+
 // ReferenceListTable - no pk
 type ReferenceListTable struct {
 	List ReferenceList `json:"list"`
@@ -26,6 +48,8 @@ type ReferenceListTable struct {
 type VoterTables struct {
 	Voters        []VoterRow         `json:"voters"`
 	Delegations   []DelegationRow    `json:"delegations"`
+	Votes         []VoteRow          `json:"votes"`
+	VoteOverrides []VoteOverrideRow  `json:"vote_overrides"`
 	ReferenceList ReferenceListTable `json:"reference_list"`
 }
 
@@ -15,6 +15,8 @@ var (
 	voteSubstore          = []byte{0x02}
 	referenceListSubStore = []byte{0x03}
 	delegateeSubStore     = []byte{0x04}
+	voteOverrideSubStore  = []byte{0x05}
+	totalStakeSubStore    = []byte{0x06}
 )
 
 // VoteStorage - vote storage
@@ -76,21 +78,57 @@ func (vs VoteStorage) GetVoter(ctx sdk.Context, accKey types.AccountKey) (*Voter
 	return voter, nil
 }
 
-// SetVoter - set voter to KVStore
+// SetVoter - set voter to KVStore, total lino stake is updated by the change of voter's stake.
 func (vs VoteStorage) SetVoter(ctx sdk.Context, accKey types.AccountKey, voter *Voter) sdk.Error {
+	prevStake := types.NewCoinFromInt64(0)
+	if prevVoter, err := vs.GetVoter(ctx, accKey); err == nil {
+		prevStake = prevVoter.LinoStake
+	}
 	store := ctx.KVStore(vs.key)
 	voterByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*voter)
 	if err != nil {
 		return ErrFailedToMarshalVoter(err)
 	}
 	store.Set(GetVoterKey(accKey), voterByte)
-	return nil
+	return vs.addTotalLinoStake(ctx, voter.LinoStake.Minus(prevStake))
 }
 
-// DeleteVoter - delete voter from KVStore
+// DeleteVoter - delete voter from KVStore, voter's stake is removed from total lino stake.
 func (vs VoteStorage) DeleteVoter(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	voter, err := vs.GetVoter(ctx, username)
+	if err != nil {
+		return nil
+	}
 	store := ctx.KVStore(vs.key)
 	store.Delete(GetVoterKey(username))
+	return vs.addTotalLinoStake(ctx, types.NewCoinFromInt64(0).Minus(voter.LinoStake))
+}
+
+// GetTotalLinoStake - get total lino stake of all voters.
+func (vs VoteStorage) GetTotalLinoStake(ctx sdk.Context) (types.Coin, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	totalByte := store.Get(getTotalLinoStakeKey())
+	if totalByte == nil {
+		return types.NewCoinFromInt64(0), nil
+	}
+	total := types.NewCoinFromInt64(0)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(totalByte, &total); err != nil {
+		return types.NewCoinFromInt64(0), ErrFailedToUnmarshalTotalStake(err)
+	}
+	return total, nil
+}
+
+func (vs VoteStorage) addTotalLinoStake(ctx sdk.Context, delta types.Coin) sdk.Error {
+	total, err := vs.GetTotalLinoStake(ctx)
+	if err != nil {
+		return err
+	}
+	totalByte, marshalErr := vs.cdc.MarshalBinaryLengthPrefixed(total.Plus(delta))
+	if marshalErr != nil {
+		return ErrFailedToMarshalTotalStake(marshalErr)
+	}
+	store := ctx.KVStore(vs.key)
+	store.Set(getTotalLinoStakeKey(), totalByte)
 	return nil
 }
 
@@ -180,6 +218,68 @@ func (vs VoteStorage) GetAllDelegators(ctx sdk.Context, voterName types.AccountK
 	return delegators, nil
 }
 
+// GetAllDelegatees - get all voters a delegator delegated to from KVStore
+func (vs VoteStorage) GetAllDelegatees(ctx sdk.Context, delegatorName types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := getDelegateePrefix(delegatorName)
+	iterator := store.Iterator(subspace(prefix))
+	defer iterator.Close()
+
+	var delegatees []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegatees = append(delegatees, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	return delegatees, nil
+}
+
+// GetVoteOverrides - get all vote overrides of a voter's delegators on a proposal from KVStore
+func (vs VoteStorage) GetVoteOverrides(
+	ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey) ([]VoteOverride, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	iterator := store.Iterator(subspace(getVoteOverridePrefix(proposalID, voter)))
+	defer iterator.Close()
+
+	var overrides []VoteOverride
+
+	for ; iterator.Valid(); iterator.Next() {
+		var override VoteOverride
+		err := vs.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &override)
+		if err != nil {
+			return nil, ErrFailedToUnmarshalVoteOverride(err)
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, nil
+}
+
+// SetVoteOverride - set vote override to KVStore
+func (vs VoteStorage) SetVoteOverride(
+	ctx sdk.Context, proposalID types.ProposalKey, override *VoteOverride) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	overrideByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*override)
+	if err != nil {
+		return ErrFailedToMarshalVoteOverride(err)
+	}
+	store.Set(GetVoteOverrideKey(proposalID, override.Voter, override.Delegator), overrideByte)
+	return nil
+}
+
+// DeleteVoteOverrides - delete all vote overrides on a proposal from KVStore
+func (vs VoteStorage) DeleteVoteOverrides(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	iterator := store.Iterator(subspace(getVoteOverrideProposalPrefix(proposalID)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
 // GetAllVotes - get all votes of a proposal from KVStore
 func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]Vote, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -200,6 +300,26 @@ func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey)
 	return votes, nil
 }
 
+// GetAllVoters - get all voters from KVStore
+func (vs VoteStorage) GetAllVoters(ctx sdk.Context) ([]Voter, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	iterator := sdk.KVStorePrefixIterator(store, voterSubstore)
+	defer iterator.Close()
+
+	var voters []Voter
+
+	for ; iterator.Valid(); iterator.Next() {
+		voterBytes := iterator.Value()
+		var voter Voter
+		err := vs.cdc.UnmarshalBinaryLengthPrefixed(voterBytes, &voter)
+		if err != nil {
+			return nil, ErrFailedToUnmarshalVoter(err)
+		}
+		voters = append(voters, voter)
+	}
+	return voters, nil
+}
+
 // GetReferenceList - get reference list from KVStore
 func (vs VoteStorage) GetReferenceList(ctx sdk.Context) (*ReferenceList, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -217,58 +337,135 @@ func (vs VoteStorage) GetReferenceList(ctx sdk.Context) (*ReferenceList, sdk.Err
 // Export - Export voter state
 func (vs VoteStorage) Export(ctx sdk.Context) *VoterTables {
 	tables := &VoterTables{}
+	vs.iterateVoterRows(ctx, func(row VoterRow) {
+		tables.Voters = append(tables.Voters, row)
+	})
+	vs.iterateDelegationRows(ctx, func(row DelegationRow) {
+		tables.Delegations = append(tables.Delegations, row)
+	})
+	vs.iterateVoteRows(ctx, func(row VoteRow) {
+		tables.Votes = append(tables.Votes, row)
+	})
+	vs.iterateVoteOverrideRows(ctx, func(row VoteOverrideRow) {
+		tables.VoteOverrides = append(tables.VoteOverrides, row)
+	})
+	tables.ReferenceList = vs.referenceListTable(ctx)
+	return tables
+}
+
+// ExportRows - stream rows of all tables through @p write, one at a time.
+func (vs VoteStorage) ExportRows(ctx sdk.Context, write func(table string, row interface{})) {
+	vs.iterateVoterRows(ctx, func(row VoterRow) {
+		write(VoterTable, row)
+	})
+	vs.iterateDelegationRows(ctx, func(row DelegationRow) {
+		write(DelegationTable, row)
+	})
+	vs.iterateVoteRows(ctx, func(row VoteRow) {
+		write(VoteTable, row)
+	})
+	vs.iterateVoteOverrideRows(ctx, func(row VoteOverrideRow) {
+		write(VoteOverrideTable, row)
+	})
+	write(ReferenceListTableName, vs.referenceListTable(ctx))
+}
+
+func (vs VoteStorage) iterateVoterRows(ctx sdk.Context, process func(VoterRow)) {
+	store := ctx.KVStore(vs.key)
+	itr := sdk.KVStorePrefixIterator(store, voterSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		username := types.AccountKey(k[1:])
+		val, err := vs.GetVoter(ctx, username)
+		if err != nil {
+			panic("failed to read voter: " + err.Error())
+		}
+		process(VoterRow{
+			Username: username,
+			Voter:    *val,
+		})
+	}
+}
+
+func (vs VoteStorage) iterateDelegationRows(ctx sdk.Context, process func(DelegationRow)) {
+	store := ctx.KVStore(vs.key)
+	itr := sdk.KVStorePrefixIterator(store, delegationSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		meDelegator := string(k[1:])
+		strs := strings.Split(meDelegator, types.KeySeparator)
+		if len(strs) != 2 {
+			panic("failed to split out meDelegator: " + meDelegator)
+		}
+		voter, delegator := types.AccountKey(strs[0]), types.AccountKey(strs[1])
+		val, err := vs.GetDelegation(ctx, voter, delegator)
+		if err != nil {
+			panic("failed to read delegation: " + err.Error())
+		}
+		process(DelegationRow{
+			Voter:      voter,
+			Delegator:  delegator,
+			Delegation: *val,
+		})
+	}
+}
+
+func (vs VoteStorage) iterateVoteRows(ctx sdk.Context, process func(VoteRow)) {
+	store := ctx.KVStore(vs.key)
+	itr := sdk.KVStorePrefixIterator(store, voteSubstore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		idVoter := string(k[1:])
+		strs := strings.Split(idVoter, types.KeySeparator)
+		if len(strs) != 2 {
+			panic("failed to split out idVoter: " + idVoter)
+		}
+		proposalID, voter := types.ProposalKey(strs[0]), types.AccountKey(strs[1])
+		val, err := vs.GetVote(ctx, proposalID, voter)
+		if err != nil {
+			panic("failed to read vote: " + err.Error())
+		}
+		process(VoteRow{
+			ProposalID: proposalID,
+			Voter:      voter,
+			Vote:       *val,
+		})
+	}
+}
+
+func (vs VoteStorage) iterateVoteOverrideRows(ctx sdk.Context, process func(VoteOverrideRow)) {
 	store := ctx.KVStore(vs.key)
-	// export table.voters
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, voterSubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			username := types.AccountKey(k[1:])
-			val, err := vs.GetVoter(ctx, username)
-			if err != nil {
-				panic("failed to read voter: " + err.Error())
-			}
-			row := VoterRow{
-				Username: username,
-				Voter:    *val,
-			}
-			tables.Voters = append(tables.Voters, row)
+	itr := sdk.KVStorePrefixIterator(store, voteOverrideSubStore)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		k := itr.Key()
+		idVoterDelegator := string(k[1:])
+		strs := strings.Split(idVoterDelegator, types.KeySeparator)
+		if len(strs) != 3 {
+			panic("failed to split out idVoterDelegator: " + idVoterDelegator)
 		}
-	}()
-	// export table.Delegations
-	func() {
-		itr := sdk.KVStorePrefixIterator(store, delegationSubstore)
-		defer itr.Close()
-		for ; itr.Valid(); itr.Next() {
-			k := itr.Key()
-			meDelegator := string(k[1:])
-			strs := strings.Split(meDelegator, types.KeySeparator)
-			if len(strs) != 2 {
-				panic("failed to split out meDelegator: " + meDelegator)
-			}
-			voter, delegator := types.AccountKey(strs[0]), types.AccountKey(strs[1])
-			val, err := vs.GetDelegation(ctx, voter, delegator)
-			if err != nil {
-				panic("failed to read delegation: " + err.Error())
-			}
-			row := DelegationRow{
-				Voter:      voter,
-				Delegator:  delegator,
-				Delegation: *val,
-			}
-			tables.Delegations = append(tables.Delegations, row)
+		override := VoteOverride{}
+		if err := vs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &override); err != nil {
+			panic("failed to read vote override: " + err.Error())
 		}
-	}()
+		process(VoteOverrideRow{
+			ProposalID: types.ProposalKey(strs[0]),
+			Override:   override,
+		})
+	}
+}
 
+func (vs VoteStorage) referenceListTable(ctx sdk.Context) ReferenceListTable {
 	list, err := vs.GetReferenceList(ctx)
 	if err != nil {
 		panic("failed to get Reference List: " + err.Error())
 	}
-	tables.ReferenceList = ReferenceListTable{
+	return ReferenceListTable{
 		List: *list,
 	}
-	return tables
 }
 
 // Import - Import voter state
@@ -288,11 +485,54 @@ func (vs VoteStorage) Import(ctx sdk.Context, ir *VoterTablesIR) {
 		err := vs.SetDelegation(ctx, v.Voter, v.Delegator, &v.Delegation)
 		check(err)
 	}
+	// import table.Votes
+	for _, v := range ir.Votes {
+		err := vs.SetVote(ctx, v.ProposalID, v.Voter, &v.Vote)
+		check(err)
+	}
+	// import table.VoteOverrides
+	for _, v := range ir.VoteOverrides {
+		err := vs.SetVoteOverride(ctx, v.ProposalID, &v.Override)
+		check(err)
+	}
 	// import table.ReferenceList
 	err := vs.SetReferenceList(ctx, &ir.ReferenceList.List)
 	check(err)
 }
 
+// ImportRow - import a row of @p table, @p read decodes the row into the given pointer.
+func (vs VoteStorage) ImportRow(ctx sdk.Context, table string, read func(row interface{}) error) {
+	check := func(e error) {
+		if e != nil {
+			panic("[vote] Failed to import: " + e.Error())
+		}
+	}
+	switch table {
+	case VoterTable:
+		row := VoterRow{}
+		check(read(&row))
+		check(vs.SetVoter(ctx, row.Username, &row.Voter))
+	case DelegationTable:
+		row := DelegationRow{}
+		check(read(&row))
+		check(vs.SetDelegation(ctx, row.Voter, row.Delegator, &row.Delegation))
+	case VoteTable:
+		row := VoteRow{}
+		check(read(&row))
+		check(vs.SetVote(ctx, row.ProposalID, row.Voter, &row.Vote))
+	case VoteOverrideTable:
+		row := VoteOverrideRow{}
+		check(read(&row))
+		check(vs.SetVoteOverride(ctx, row.ProposalID, &row.Override))
+	case ReferenceListTableName:
+		row := ReferenceListTable{}
+		check(read(&row))
+		check(vs.SetReferenceList(ctx, &row.List))
+	default:
+		panic("[vote] Failed to import: unknown table " + table)
+	}
+}
+
 // SetReferenceList - set reference list to KVStore
 func (vs VoteStorage) SetReferenceList(ctx sdk.Context, lst *ReferenceList) sdk.Error {
 	store := ctx.KVStore(vs.key)
@@ -327,10 +567,27 @@ func GetVoterKey(me types.AccountKey) []byte {
 	return append(voterSubstore, me...)
 }
 
+func getVoteOverrideProposalPrefix(id types.ProposalKey) []byte {
+	return append(append(voteOverrideSubStore, id...), types.KeySeparator...)
+}
+
+func getVoteOverridePrefix(id types.ProposalKey, voter types.AccountKey) []byte {
+	return append(append(getVoteOverrideProposalPrefix(id), voter...), types.KeySeparator...)
+}
+
+// GetVoteOverrideKey - "vote override substore" + "proposalID" + "voter" + "delegator"
+func GetVoteOverrideKey(proposalID types.ProposalKey, voter, delegator types.AccountKey) []byte {
+	return append(getVoteOverridePrefix(proposalID, voter), delegator...)
+}
+
 func getReferenceListKey() []byte {
 	return referenceListSubStore
 }
 
+func getTotalLinoStakeKey() []byte {
+	return totalStakeSubStore
+}
+
 func getDelegateePrefix(me types.AccountKey) []byte {
 	return append(append(delegateeSubStore, me...), types.KeySeparator...)
 }
@@ -186,11 +186,24 @@ func TestVoter(t *testing.T) {
 	voterPtr, err := vs.GetVoter(ctx, user)
 	assert.Nil(t, err)
 	assert.Equal(t, voter, *voterPtr, "voter should be equal")
+	total, err := vs.GetTotalLinoStake(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, voter.LinoStake, total)
+
+	voter.LinoStake = types.NewCoinFromInt64(400)
+	err = vs.SetVoter(ctx, user, &voter)
+	assert.Nil(t, err)
+	total, err = vs.GetTotalLinoStake(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, voter.LinoStake, total)
 
 	vs.DeleteVoter(ctx, user)
 	voterPtr, err = vs.GetVoter(ctx, user)
 	assert.Nil(t, voterPtr)
 	assert.Equal(t, ErrVoterNotFound(), err)
+	total, err = vs.GetTotalLinoStake(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), total)
 }
 
 func TestVote(t *testing.T) {
@@ -512,3 +525,107 @@ func TestAllDelegation(t *testing.T) {
 		}
 	}
 }
+
+func TestVoteOverride(t *testing.T) {
+	ctx, vs := setup(t)
+
+	user1, user2, user3 := types.AccountKey("user1"), types.AccountKey("user2"), types.AccountKey("user3")
+	proposalID1, proposalID2 := types.ProposalKey("1"), types.ProposalKey("2")
+	override1 := &VoteOverride{Voter: user1, Delegator: user2, Amount: types.NewCoinFromInt64(100)}
+	override2 := &VoteOverride{Voter: user1, Delegator: user3, Amount: types.NewCoinFromInt64(200)}
+	override3 := &VoteOverride{Voter: user2, Delegator: user3, Amount: types.NewCoinFromInt64(300)}
+	for _, override := range []*VoteOverride{override1, override2, override3} {
+		if err := vs.SetVoteOverride(ctx, proposalID1, override); err != nil {
+			t.Errorf("%s: failed to set vote override, got err %v", "TestVoteOverride", err)
+		}
+	}
+
+	overrides, err := vs.GetVoteOverrides(ctx, proposalID1, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []VoteOverride{*override1, *override2}, overrides)
+	overrides, err = vs.GetVoteOverrides(ctx, proposalID1, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, []VoteOverride{*override3}, overrides)
+	overrides, err = vs.GetVoteOverrides(ctx, proposalID2, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(overrides))
+
+	// overrides of other proposals are kept
+	assert.Nil(t, vs.SetVoteOverride(ctx, proposalID2, override1))
+	assert.Nil(t, vs.DeleteVoteOverrides(ctx, proposalID1))
+	for _, voter := range []types.AccountKey{user1, user2} {
+		overrides, err = vs.GetVoteOverrides(ctx, proposalID1, voter)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(overrides))
+	}
+	overrides, err = vs.GetVoteOverrides(ctx, proposalID2, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []VoteOverride{*override1}, overrides)
+}
+
+func TestExportImportRows(t *testing.T) {
+	ctx, vs := setup(t)
+
+	user1, user2 := types.AccountKey("user1"), types.AccountKey("user2")
+	proposalID := types.ProposalKey("1")
+	voter := &Voter{
+		Username:         user1,
+		LinoStake:        types.NewCoinFromInt64(100),
+		DelegatedPower:   types.NewCoinFromInt64(10),
+		DelegateToOthers: types.NewCoinFromInt64(0),
+		Interest:         types.NewCoinFromInt64(0),
+	}
+	delegation := &Delegation{Delegator: user2, Amount: types.NewCoinFromInt64(10)}
+	vote := &Vote{Voter: user1, VotingPower: types.NewCoinFromInt64(100), Result: true}
+	override := &VoteOverride{Voter: user1, Delegator: user2, Amount: types.NewCoinFromInt64(10)}
+	assert.Nil(t, vs.InitGenesis(ctx))
+	assert.Nil(t, vs.SetVoter(ctx, user1, voter))
+	assert.Nil(t, vs.SetDelegation(ctx, user1, user2, delegation))
+	assert.Nil(t, vs.SetVote(ctx, proposalID, user1, vote))
+	assert.Nil(t, vs.SetVoteOverride(ctx, proposalID, override))
+
+	type row struct {
+		table string
+		bytes []byte
+	}
+	rows := []row{}
+	vs.ExportRows(ctx, func(table string, r interface{}) {
+		bz, err := vs.cdc.MarshalJSON(r)
+		assert.Nil(t, err)
+		rows = append(rows, row{table: table, bytes: bz})
+	})
+	assert.Equal(t, 5, len(rows))
+	assert.Equal(t, VoterTable, rows[0].table)
+	assert.Equal(t, DelegationTable, rows[1].table)
+	assert.Equal(t, VoteTable, rows[2].table)
+	assert.Equal(t, VoteOverrideTable, rows[3].table)
+	assert.Equal(t, ReferenceListTableName, rows[4].table)
+
+	ctx, vs = setup(t)
+	for _, r := range rows {
+		bz := r.bytes
+		vs.ImportRow(ctx, r.table, func(v interface{}) error {
+			return vs.cdc.UnmarshalJSON(bz, v)
+		})
+	}
+	v, err := vs.GetVoter(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, voter, v)
+	d, err := vs.GetDelegation(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, delegation, d)
+	delegatees, err := vs.GetAllDelegatees(ctx, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user1}, delegatees)
+	vo, err := vs.GetVote(ctx, proposalID, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, vote, vo)
+	overrides, err := vs.GetVoteOverrides(ctx, proposalID, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []VoteOverride{*override}, overrides)
+
+	// the same rows are carried by the table export
+	tables := vs.Export(ctx)
+	assert.Equal(t, []VoteRow{{ProposalID: proposalID, Voter: user1, Vote: *vote}}, tables.Votes)
+	assert.Equal(t, []VoteOverrideRow{{ProposalID: proposalID, Override: *override}}, tables.VoteOverrides)
+}
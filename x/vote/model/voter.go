@@ -21,6 +21,14 @@ type Vote struct {
 	Result      bool             `json:"result"`
 }
 
+// VoteOverride - a delegator voted on a proposal by itself, Amount it delegated
+// to Voter is counted to the delegator's vote instead of the voter's vote
+type VoteOverride struct {
+	Voter     types.AccountKey `json:"voter"`
+	Delegator types.AccountKey `json:"delegator"`
+	Amount    types.Coin       `json:"amount"`
+}
+
 // Delegation - normal user can delegate money to a voter to increase voter's voting power
 type Delegation struct {
 	Delegator types.AccountKey `json:"delegator"`